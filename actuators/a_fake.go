@@ -0,0 +1,119 @@
+package actuators
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
+	"sync"
+)
+
+// FakeBoard keeps the pin levels in memory and records every level change,
+// so the irrigation logic can run and be checked without a Raspberry Pi.
+// Setting a pin to the level it already has is no change and is not recorded,
+// so the history only grows when relays are actually switched.
+type FakeBoard struct {
+	clock   clock.Clock
+	mutex   sync.RWMutex
+	pins    map[int]*fakePin
+	changes []LevelChange
+}
+
+type fakePin struct {
+	board *FakeBoard
+	gpio  int
+	level Level
+	// switched is false until the level was set the first time
+	switched bool
+}
+
+func NewFakeBoard(clock clock.Clock) Board {
+	return &FakeBoard{
+		clock:   clock,
+		pins:    make(map[int]*fakePin),
+		changes: make([]LevelChange, 0),
+	}
+}
+
+func (b *FakeBoard) Pin(gpio int) (Pin, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if p, ok := b.pins[gpio]; ok {
+		return p, nil
+	}
+
+	if _, err := GetGPIO(gpio); err != nil {
+		return nil, err
+	}
+
+	p := &fakePin{
+		board: b,
+		gpio:  gpio,
+	}
+	b.pins[gpio] = p
+
+	return p, nil
+}
+
+// Changes returns all recorded level changes in the order they happened.
+func (b *FakeBoard) Changes() []LevelChange {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	changes := make([]LevelChange, len(b.changes))
+	copy(changes, b.changes)
+	return changes
+}
+
+// PinChanges returns the recorded level changes of a single gpio.
+func (b *FakeBoard) PinChanges(gpio int) []LevelChange {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	changes := make([]LevelChange, 0)
+	for _, c := range b.changes {
+		if c.GPIO == gpio {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// Level returns the current level of a gpio, pins never switched are Low.
+func (b *FakeBoard) Level(gpio int) Level {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if p, ok := b.pins[gpio]; ok {
+		return p.level
+	}
+	return Low
+}
+
+func (p *fakePin) GPIO() int {
+	return p.gpio
+}
+
+func (p *fakePin) Out(level Level) error {
+	p.board.mutex.Lock()
+	defer p.board.mutex.Unlock()
+
+	if p.switched && p.level == level {
+		return nil
+	}
+
+	p.level = level
+	p.switched = true
+	p.board.changes = append(p.board.changes, LevelChange{
+		GPIO:  p.gpio,
+		Level: level,
+		Time:  p.board.clock.Now(),
+	})
+
+	return nil
+}
+
+func (p *fakePin) Read() Level {
+	p.board.mutex.RLock()
+	defer p.board.mutex.RUnlock()
+
+	return p.level
+}
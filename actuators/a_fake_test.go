@@ -0,0 +1,32 @@
+package actuators
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
+	"testing"
+	"time"
+)
+
+func TestFakeBoardRecordsOnlyChanges(t *testing.T) {
+	c := clock.NewManual(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+	board := NewFakeBoard(c).(*FakeBoard)
+	pin, err := board.Pin(23)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first level is recorded even if it is the level of a pin never switched
+	for _, level := range []Level{Low, Low, High, High, High, Low} {
+		if err = pin.Out(level); err != nil {
+			t.Fatal(err)
+		}
+		c.Advance(time.Second)
+	}
+
+	changes := board.PinChanges(23)
+	if len(changes) != 3 || changes[0].Level != Low || changes[1].Level != High || changes[2].Level != Low {
+		t.Fatalf("got changes %+v, want low, high and low", changes)
+	}
+	if d := changes[2].Time.Sub(changes[1].Time); d != 3*time.Second {
+		t.Errorf("pin was high for %s, want 3s", d)
+	}
+}
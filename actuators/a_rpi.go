@@ -0,0 +1,128 @@
+package actuators
+
+import (
+	"fmt"
+	"periph.io/x/conn/v3/gpio"
+	"periph.io/x/host/v3/rpi"
+	"sync"
+)
+
+type rpiBoard struct {
+	mutex sync.Mutex
+	pins  map[int]*rpiPin
+}
+
+type rpiPin struct {
+	gpio  int
+	pin   gpio.PinIO
+	mutex sync.RWMutex
+	level Level
+}
+
+// NewRPiBoard returns a board backed by the header pins of a Raspberry Pi.
+// The periph host has to be initialized before any pin is switched.
+func NewRPiBoard() Board {
+	return &rpiBoard{
+		pins: make(map[int]*rpiPin),
+	}
+}
+
+func (b *rpiBoard) Pin(gpio int) (Pin, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if p, ok := b.pins[gpio]; ok {
+		return p, nil
+	}
+
+	pin, err := GetGPIO(gpio)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &rpiPin{
+		gpio: gpio,
+		pin:  pin,
+	}
+	b.pins[gpio] = p
+
+	return p, nil
+}
+
+func (p *rpiPin) GPIO() int {
+	return p.gpio
+}
+
+func (p *rpiPin) Out(level Level) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if err := p.pin.Out(gpio.Level(level)); err != nil {
+		return err
+	}
+
+	p.level = level
+	return nil
+}
+
+func (p *rpiPin) Read() Level {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.level
+}
+
+func GetGPIO(gpio int) (gpio.PinIO, error) {
+	switch gpio {
+	case 2:
+		return rpi.P1_3, nil
+	case 3:
+		return rpi.P1_5, nil
+	case 4:
+		return rpi.P1_7, nil
+	case 5:
+		return rpi.P1_29, nil
+	case 6:
+		return rpi.P1_31, nil
+	case 7:
+		return rpi.P1_26, nil
+	case 8:
+		return rpi.P1_24, nil
+	case 9:
+		return rpi.P1_21, nil
+	case 10:
+		return rpi.P1_19, nil
+	case 11:
+		return rpi.P1_23, nil
+	case 12:
+		return rpi.P1_32, nil
+	case 13:
+		return rpi.P1_33, nil
+	case 16:
+		return rpi.P1_36, nil
+	case 17:
+		return rpi.P1_11, nil
+	case 18:
+		return rpi.P1_12, nil
+	case 19:
+		return rpi.P1_35, nil
+	case 20:
+		return rpi.P1_38, nil
+	case 21:
+		return rpi.P1_40, nil
+	case 22:
+		return rpi.P1_15, nil
+	case 23:
+		return rpi.P1_16, nil
+	case 24:
+		return rpi.P1_18, nil
+	case 25:
+		return rpi.P1_22, nil
+	case 26:
+		return rpi.P1_37, nil
+	case 27:
+		return rpi.P1_13, nil
+	default:
+		return nil, fmt.Errorf("gpio %d cant found", gpio)
+	}
+}
@@ -0,0 +1,74 @@
+package actuators
+
+import (
+	"time"
+)
+
+type Level bool
+
+const (
+	Low  Level = false
+	High Level = true
+)
+
+func (l Level) String() string {
+	if l == High {
+		return "High"
+	}
+	return "Low"
+}
+
+type Pin interface {
+	GPIO() int
+	Out(level Level) error
+	Read() Level
+}
+
+type Board interface {
+	Pin(gpio int) (Pin, error)
+}
+
+type LevelChange struct {
+	GPIO  int
+	Level Level
+	Time  time.Time
+}
+
+// Relay drives a low active relay, like the ones switching the pump and the valves of a station.
+type Relay struct {
+	pin Pin
+}
+
+func NewRelay(board Board, gpio int) (*Relay, error) {
+	pin, err := board.Pin(gpio)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Relay{
+		pin: pin,
+	}, nil
+}
+
+func (r *Relay) GPIO() int {
+	return r.pin.GPIO()
+}
+
+func (r *Relay) On() error {
+	return r.pin.Out(Low)
+}
+
+func (r *Relay) Off() error {
+	return r.pin.Out(High)
+}
+
+func (r *Relay) Set(on bool) error {
+	if on {
+		return r.On()
+	}
+	return r.Off()
+}
+
+func (r *Relay) IsOn() bool {
+	return r.pin.Read() == Low
+}
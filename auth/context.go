@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+)
+
+type contextKey struct{}
+
+// Identity is who sent a request, SessionID or APIKeyID tells how the user authenticated.
+// Role is what the request may do, for API keys it can be lower than the role of the user.
+type Identity struct {
+	User      model.User
+	Role      model.Role
+	SessionID uint64
+	APIKeyID  uint64
+}
+
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, identity)
+}
+
+// ForContext returns the identity of the request, nil if it was not authenticated.
+func ForContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(contextKey{}).(*Identity)
+	return identity
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MinPasswordLength is the length a password needs at least.
+const MinPasswordLength = 8
+
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// UnknownUserHash is checked instead of a password hash for logins of users which do not exist. It has the cost of
+// HashPassword, so a login takes as long whether the user exists or not and does not tell which usernames exist.
+const UnknownUserHash = "$2a$10$oWLbZ9xTeuRR2vSsELC3seW1pnLkYXRZAerNp4j2nN/Rs3/6o98La"
+
+// HashPassword returns the bcrypt hash of the password which is stored instead of it.
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword compares the password with a hash from HashPassword.
+func CheckPassword(hash string, password string) error {
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		return ErrInvalidCredentials
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"golang.org/x/crypto/bcrypt"
+	"testing"
+)
+
+func TestUnknownUserHashCostsLikeAPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse battery")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	passwordCost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknownCost, err := bcrypt.Cost([]byte(UnknownUserHash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unknownCost != passwordCost {
+		t.Errorf("unknown user hash has cost %d, passwords have %d", unknownCost, passwordCost)
+	}
+
+	if err = CheckPassword(UnknownUserHash, ""); err != ErrInvalidCredentials {
+		t.Errorf("checking the unknown user hash returned %v, want %v", err, ErrInvalidCredentials)
+	}
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// SessionPrefix starts the tokens returned by a login.
+	SessionPrefix = "lps_"
+	// APIKeyPrefix starts the long-lived keys created for scripts.
+	APIKeyPrefix = "lpk_"
+)
+
+// NewToken returns a random token with the prefix. Only the hash of a token is stored, the token itself is shown once.
+func NewToken(prefix string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return prefix + hex.EncodeToString(b), nil
+}
+
+// HashToken returns the hash a token is stored and looked up by.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// BearerToken returns the token of an Authorization header value like "Bearer lps_...", a value without scheme is taken as it is.
+func BearerToken(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) > 7 && strings.EqualFold(value[:7], "bearer ") {
+		return strings.TrimSpace(value[7:])
+	}
+	return value
+}
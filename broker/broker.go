@@ -0,0 +1,131 @@
+package broker
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Broker hands messages of the control loops to subscribers like websocket clients.
+// Publishing never waits for a subscriber: every subscriber has a queue of its own and when it is full,
+// the policy of the subscriber decides which message is given up. So a slow or dead client can not hold up
+// a station loop, it only misses messages, which the broker counts.
+type Broker struct {
+	mutex         sync.RWMutex
+	subscriptions map[string]map[*Subscription]struct{}
+	metrics       map[string]*topicMetrics
+}
+
+// Metrics are the counters of a topic since the broker was started.
+type Metrics struct {
+	Topic       string
+	Subscribers int
+	Published   uint64
+	Delivered   uint64
+	Dropped     uint64
+	Coalesced   uint64
+}
+
+type topicMetrics struct {
+	published uint64
+	delivered uint64
+	dropped   uint64
+	coalesced uint64
+}
+
+func New() *Broker {
+	return &Broker{
+		subscriptions: make(map[string]map[*Subscription]struct{}),
+		metrics:       make(map[string]*topicMetrics),
+	}
+}
+
+// Subscribe adds a subscriber to the topic, it gets every message published after it subscribed.
+// The subscription has to be closed once the subscriber is gone.
+func (b *Broker) Subscribe(topic string, options Options) *Subscription {
+	if options.Size <= 0 {
+		options.Size = DefaultQueueSize
+	}
+
+	sub := &Subscription{
+		broker:  b,
+		topic:   topic,
+		options: options,
+		queue:   make([]message, 0, options.Size),
+		signal:  make(chan struct{}, 1),
+		closed:  make(chan struct{}),
+	}
+
+	b.mutex.Lock()
+	subs, ok := b.subscriptions[topic]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		b.subscriptions[topic] = subs
+	}
+	subs[sub] = struct{}{}
+	sub.metrics = b.metricsOf(topic)
+	b.mutex.Unlock()
+
+	return sub
+}
+
+// Publish queues the payload for every subscriber of the topic whose filter accepts it and returns right away.
+// Subscribers with the Coalesce policy replace a queued message with the same key, like the status of the same port.
+func (b *Broker) Publish(topic string, key string, payload interface{}) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	m := b.metrics[topic]
+	if m == nil {
+		// nobody ever subscribed, there is nothing to count
+		return
+	}
+
+	atomic.AddUint64(&m.published, 1)
+	for sub := range b.subscriptions[topic] {
+		if sub.options.Filter != nil && !sub.options.Filter(payload) {
+			continue
+		}
+		sub.push(message{key: key, payload: payload}, true)
+	}
+}
+
+// Metrics returns the counters of every topic, sorted by topic.
+func (b *Broker) Metrics() []Metrics {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	metrics := make([]Metrics, 0, len(b.metrics))
+	for topic, m := range b.metrics {
+		metrics = append(metrics, Metrics{
+			Topic:       topic,
+			Subscribers: len(b.subscriptions[topic]),
+			Published:   atomic.LoadUint64(&m.published),
+			Delivered:   atomic.LoadUint64(&m.delivered),
+			Dropped:     atomic.LoadUint64(&m.dropped),
+			Coalesced:   atomic.LoadUint64(&m.coalesced),
+		})
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Topic < metrics[j].Topic
+	})
+
+	return metrics
+}
+
+func (b *Broker) unsubscribe(sub *Subscription) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	delete(b.subscriptions[sub.topic], sub)
+}
+
+// metricsOf returns the counters of a topic, the caller has to hold the write lock.
+func (b *Broker) metricsOf(topic string) *topicMetrics {
+	m, ok := b.metrics[topic]
+	if !ok {
+		m = &topicMetrics{}
+		b.metrics[topic] = m
+	}
+	return m
+}
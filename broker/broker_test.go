@@ -0,0 +1,162 @@
+package broker
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// receive takes all queued payloads of the subscription without waiting for more.
+func receive(t *testing.T, sub *Subscription) []interface{} {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	payloads := make([]interface{}, 0)
+	for {
+		payload, ok := sub.Next(ctx)
+		if !ok {
+			return payloads
+		}
+		payloads = append(payloads, payload)
+	}
+}
+
+func assertPayloads(t *testing.T, got []interface{}, want ...interface{}) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func metricsOf(b *Broker, topic string) Metrics {
+	for _, m := range b.Metrics() {
+		if m.Topic == topic {
+			return m
+		}
+	}
+	return Metrics{}
+}
+
+func TestDropOldestKeepsTheNewestMessages(t *testing.T) {
+	b := New()
+	sub := b.Subscribe("events", Options{Size: 3, Policy: DropOldest})
+	defer sub.Close()
+
+	for i := 1; i <= 5; i++ {
+		b.Publish("events", "same", i)
+	}
+
+	assertPayloads(t, receive(t, sub), 3, 4, 5)
+	if sub.Dropped() != 2 {
+		t.Errorf("subscriber dropped %d messages, want 2", sub.Dropped())
+	}
+	if m := metricsOf(b, "events"); m.Published != 5 || m.Delivered != 3 || m.Dropped != 2 || m.Coalesced != 0 {
+		t.Errorf("got metrics %+v", m)
+	}
+}
+
+func TestCoalesceKeepsTheLatestMessageOfAKey(t *testing.T) {
+	b := New()
+	sub := b.Subscribe("status", Options{Size: 3, Policy: Coalesce})
+	defer sub.Close()
+
+	b.Publish("status", "A", "A1")
+	b.Publish("status", "B", "B1")
+	b.Publish("status", "A", "A2")
+	assertPayloads(t, receive(t, sub), "A2", "B1")
+
+	// once the queue is full, a new key drops the oldest message
+	for _, key := range []string{"A", "B", "C", "D"} {
+		b.Publish("status", key, key+"3")
+	}
+	b.Publish("status", "C", "C4")
+	assertPayloads(t, receive(t, sub), "B3", "C4", "D3")
+
+	if m := metricsOf(b, "status"); m.Dropped != 1 || m.Coalesced != 2 {
+		t.Errorf("got metrics %+v, want 1 dropped and 2 coalesced", m)
+	}
+}
+
+func TestSendDoesNotReplaceNewerMessages(t *testing.T) {
+	b := New()
+	sub := b.Subscribe("status", Options{Policy: Coalesce})
+	defer sub.Close()
+
+	b.Publish("status", "A", "published")
+	sub.Send("A", "snapshot")
+	sub.Send("B", "snapshot")
+
+	assertPayloads(t, receive(t, sub), "published", "snapshot")
+}
+
+func TestFilterSelectsThePayloads(t *testing.T) {
+	b := New()
+	sub := b.Subscribe("readings", Options{Filter: func(payload interface{}) bool {
+		return payload.(int)%2 == 0
+	}})
+	defer sub.Close()
+
+	for i := 1; i <= 4; i++ {
+		b.Publish("readings", "", i)
+	}
+
+	assertPayloads(t, receive(t, sub), 2, 4)
+}
+
+func TestCloseUnsubscribes(t *testing.T) {
+	b := New()
+	sub := b.Subscribe("events", Options{})
+	other := b.Subscribe("events", Options{})
+	defer other.Close()
+
+	b.Publish("events", "", 1)
+	sub.Close()
+	b.Publish("events", "", 2)
+
+	if _, ok := sub.Next(context.Background()); ok {
+		t.Error("closed subscription returned a message")
+	}
+	assertPayloads(t, receive(t, other), 1, 2)
+	if m := metricsOf(b, "events"); m.Subscribers != 1 {
+		t.Errorf("topic has %d subscribers after a close, want 1", m.Subscribers)
+	}
+}
+
+func TestRunEndsWithTheContext(t *testing.T) {
+	b := New()
+	sub := b.Subscribe("events", Options{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	delivered := make(chan interface{})
+	done := make(chan struct{})
+	go func() {
+		sub.Run(ctx, func(payload interface{}) bool {
+			delivered <- payload
+			return true
+		})
+		close(done)
+	}()
+
+	b.Publish("events", "", 1)
+	if payload := <-delivered; payload != 1 {
+		t.Errorf("got %v, want 1", payload)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("run did not end with the context")
+	}
+	if m := metricsOf(b, "events"); m.Subscribers != 0 {
+		t.Errorf("topic has %d subscribers after run ended, want 0", m.Subscribers)
+	}
+}
@@ -0,0 +1,177 @@
+package broker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultQueueSize is the number of messages a subscriber can fall behind before messages are dropped.
+const DefaultQueueSize = 64
+
+// Policy decides what happens to a message for a subscriber whose queue is full.
+type Policy int
+
+const (
+	// DropOldest gives up the oldest queued message to make room for the new one.
+	DropOldest Policy = iota
+	// Coalesce replaces a queued message with the same key, so a subscriber only gets the latest state of a thing.
+	// Messages with new keys drop the oldest message once the queue is full.
+	Coalesce
+)
+
+func (p Policy) String() string {
+	switch p {
+	case DropOldest:
+		return "drop oldest"
+	case Coalesce:
+		return "coalesce"
+	}
+	return "unknown"
+}
+
+// Options configure the queue of a subscriber.
+type Options struct {
+	// Size is the length of the queue, 0 uses DefaultQueueSize
+	Size   int
+	Policy Policy
+	// Filter selects the payloads of the topic the subscriber is interested in, nil accepts every payload
+	Filter func(payload interface{}) bool
+}
+
+type message struct {
+	key     string
+	payload interface{}
+}
+
+// Subscription is the queue of one subscriber of a topic.
+type Subscription struct {
+	broker  *Broker
+	topic   string
+	options Options
+	metrics *topicMetrics
+
+	mutex    sync.Mutex
+	queue    []message
+	dropped  uint64
+	dropping bool
+
+	signal    chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Send queues a payload for this subscriber only, like the current state of things a new subscriber starts with.
+// With the Coalesce policy a queued message with the same key is newer than the state and is kept.
+func (s *Subscription) Send(key string, payload interface{}) {
+	s.push(message{key: key, payload: payload}, false)
+}
+
+// Next waits for the next message and returns its payload. It returns false once ctx is done or the subscription is closed.
+func (s *Subscription) Next(ctx context.Context) (interface{}, bool) {
+	for {
+		s.mutex.Lock()
+		if len(s.queue) > 0 {
+			m := s.queue[0]
+			s.queue[0] = message{}
+			s.queue = s.queue[1:]
+			s.dropping = false
+			s.mutex.Unlock()
+
+			atomic.AddUint64(&s.metrics.delivered, 1)
+			return m.payload, true
+		}
+		s.mutex.Unlock()
+
+		select {
+		case <-s.signal:
+		case <-s.closed:
+			return nil, false
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+}
+
+// Run hands every message to deliver until ctx is done, the subscription is closed or deliver returns false.
+// deliver may block, it only holds up this subscriber. The subscription is closed when Run returns.
+func (s *Subscription) Run(ctx context.Context, deliver func(payload interface{}) bool) {
+	defer s.Close()
+
+	for {
+		payload, ok := s.Next(ctx)
+		if !ok || !deliver(payload) {
+			return
+		}
+	}
+}
+
+// Dropped returns how many messages this subscriber missed because its queue was full.
+func (s *Subscription) Dropped() uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.dropped
+}
+
+// Close removes the subscriber from its topic, queued messages are discarded.
+func (s *Subscription) Close() {
+	s.closeOnce.Do(func() {
+		s.broker.unsubscribe(s)
+		close(s.closed)
+
+		s.mutex.Lock()
+		s.queue = nil
+		s.mutex.Unlock()
+	})
+}
+
+// push queues the message, replace tells whether it replaces a queued message with the same key or is given up for it.
+func (s *Subscription) push(m message, replace bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	select {
+	case <-s.closed:
+		return
+	default:
+	}
+
+	if s.options.Policy == Coalesce && m.key != "" {
+		for i := range s.queue {
+			if s.queue[i].key == m.key {
+				if replace {
+					s.queue[i] = m
+				}
+				atomic.AddUint64(&s.metrics.coalesced, 1)
+				return
+			}
+		}
+	}
+
+	if len(s.queue) >= s.options.Size {
+		s.drop()
+		s.queue[0] = message{}
+		s.queue = s.queue[1:]
+	}
+
+	s.queue = append(s.queue, m)
+
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+// drop counts a dropped message, the caller has to hold the mutex. It logs once when the subscriber starts to fall behind,
+// not for every message until it caught up again.
+func (s *Subscription) drop() {
+	s.dropped++
+	atomic.AddUint64(&s.metrics.dropped, 1)
+
+	if !s.dropping {
+		s.dropping = true
+		log.Println("subscriber of", s.topic, "falls behind, dropping messages:", s.options.Policy, "total", s.dropped)
+	}
+}
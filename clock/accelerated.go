@@ -0,0 +1,108 @@
+package clock
+
+import (
+	"time"
+)
+
+// Accelerated runs factor times faster than the real clock, starting at start.
+type Accelerated struct {
+	start     time.Time
+	realStart time.Time
+	factor    float64
+}
+
+func NewAccelerated(start time.Time, factor float64) *Accelerated {
+	if factor <= 0 {
+		factor = 1
+	}
+
+	return &Accelerated{
+		start:     start,
+		realStart: time.Now(),
+		factor:    factor,
+	}
+}
+
+func (a *Accelerated) Now() time.Time {
+	elapsed := time.Since(a.realStart)
+	return a.start.Add(time.Duration(float64(elapsed) * a.factor))
+}
+
+func (a *Accelerated) Since(t time.Time) time.Duration {
+	return a.Now().Sub(t)
+}
+
+func (a *Accelerated) Sleep(d time.Duration) {
+	time.Sleep(a.real(d))
+}
+
+func (a *Accelerated) After(d time.Duration) <-chan time.Time {
+	return a.NewTimer(d).C()
+}
+
+func (a *Accelerated) NewTimer(d time.Duration) Timer {
+	ch := make(chan time.Time, 1)
+	return &timer{
+		timer: time.AfterFunc(a.real(d), func() {
+			ch <- a.Now()
+		}),
+		ch: ch,
+	}
+}
+
+func (a *Accelerated) AfterFunc(d time.Duration, f func()) Timer {
+	return &timer{
+		timer: time.AfterFunc(a.real(d), f),
+	}
+}
+
+func (a *Accelerated) NewTicker(d time.Duration) Ticker {
+	t := &acceleratedTicker{
+		clock:  a,
+		ticker: time.NewTicker(a.real(d)),
+		ch:     make(chan time.Time, 1),
+		done:   make(chan struct{}),
+	}
+	go t.run()
+	return t
+}
+
+// real returns the real duration which passes while the clock moves by d.
+func (a *Accelerated) real(d time.Duration) time.Duration {
+	r := time.Duration(float64(d) / a.factor)
+	if r <= 0 && d > 0 {
+		r = 1
+	}
+	return r
+}
+
+// acceleratedTicker delivers the time of the accelerated clock instead of the real time.
+type acceleratedTicker struct {
+	clock  *Accelerated
+	ticker *time.Ticker
+	ch     chan time.Time
+	done   chan struct{}
+}
+
+func (t *acceleratedTicker) run() {
+	for {
+		select {
+		case <-t.ticker.C:
+			select {
+			case t.ch <- t.clock.Now():
+			default:
+			}
+		case <-t.done:
+			return
+		}
+	}
+}
+
+func (t *acceleratedTicker) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *acceleratedTicker) Stop() {
+	t.ticker.Stop()
+	close(t.done)
+}
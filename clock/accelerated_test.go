@@ -0,0 +1,46 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAcceleratedRunsFaster(t *testing.T) {
+	start := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	a := NewAccelerated(start, 3600)
+
+	// an hour of the clock passes in a second
+	realStart := time.Now()
+	at := <-a.After(time.Hour / 10)
+	if d := time.Since(realStart); d < 90*time.Millisecond || d > time.Second {
+		t.Errorf("waiting 6 minutes took %s, want about 100ms", d)
+	}
+	if at.Before(start.Add(time.Hour/10)) || at.After(start.Add(time.Hour)) {
+		t.Errorf("timer delivered %s, want the time of the clock after 6 minutes", at)
+	}
+
+	stopped := a.NewTimer(time.Hour / 10)
+	if !stopped.Stop() {
+		t.Error("stopping a pending timer returned false")
+	}
+	select {
+	case <-stopped.C():
+		t.Error("stopped timer fired")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	ticker := a.NewTicker(time.Minute)
+	defer ticker.Stop()
+	first := <-ticker.C()
+	second := <-ticker.C()
+	if d := second.Sub(first); d < 30*time.Second || d > 10*time.Minute {
+		t.Errorf("ticks are %s apart, want about a minute of the clock", d)
+	}
+}
+
+func TestAcceleratedFactorMustBePositive(t *testing.T) {
+	a := NewAccelerated(time.Now(), 0)
+	if a.factor != 1 {
+		t.Errorf("factor is %v, want 1 for a factor which is not positive", a.factor)
+	}
+}
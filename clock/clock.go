@@ -0,0 +1,99 @@
+package clock
+
+import (
+	"time"
+)
+
+// Clock is the source of time of the control loop, the sensor worker and the fakes.
+// Besides the real clock there is a manual clock which only moves when told to and an accelerated clock,
+// so days of irrigation can be simulated in seconds.
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	Sleep(d time.Duration)
+	// After waits for d to pass, the wait can not be given up. Waits which may be given up use NewTimer.
+	After(d time.Duration) <-chan time.Time
+	NewTimer(d time.Duration) Timer
+	AfterFunc(d time.Duration, f func()) Timer
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a timer of NewTimer or AfterFunc, timers of AfterFunc have no channel.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+type realClock struct{}
+
+// Real returns the clock of the system.
+func Real() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (realClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	t := time.NewTimer(d)
+	return &timer{
+		timer: t,
+		ch:    t.C,
+	}
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return &timer{
+		timer: time.AfterFunc(d, f),
+	}
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{
+		ticker: time.NewTicker(d),
+	}
+}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t *realTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t *realTicker) Stop() {
+	t.ticker.Stop()
+}
+
+// timer is a timer of the time package, its channel may deliver the time of another clock than the system clock.
+type timer struct {
+	timer *time.Timer
+	ch    <-chan time.Time
+}
+
+func (t *timer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *timer) Stop() bool {
+	return t.timer.Stop()
+}
@@ -0,0 +1,209 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Manual is a clock which only moves on Advance, timers and tickers fire in the order of their deadlines while it does.
+// Goroutines sleeping on it block until the clock is advanced past their deadline.
+type Manual struct {
+	mutex   sync.Mutex
+	changed *sync.Cond
+	now     time.Time
+	waiters []*waiter
+}
+
+type waiter struct {
+	clock    *Manual
+	deadline time.Time
+	period   time.Duration
+	ch       chan time.Time
+	f        func()
+	sleeper  bool
+}
+
+func NewManual(start time.Time) *Manual {
+	m := &Manual{
+		now: start,
+	}
+	m.changed = sync.NewCond(&m.mutex)
+	return m
+}
+
+func (m *Manual) Now() time.Time {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.now
+}
+
+func (m *Manual) Since(t time.Time) time.Duration {
+	return m.Now().Sub(t)
+}
+
+func (m *Manual) Sleep(d time.Duration) {
+	<-m.After(d)
+}
+
+func (m *Manual) After(d time.Duration) <-chan time.Time {
+	return m.NewTimer(d).C()
+}
+
+// NewTimer returns a timer whose receiver sleeps on the clock until it fires or is stopped.
+func (m *Manual) NewTimer(d time.Duration) Timer {
+	w := &waiter{
+		ch:      make(chan time.Time, 1),
+		sleeper: true,
+	}
+	m.add(w, d)
+	return w
+}
+
+func (m *Manual) AfterFunc(d time.Duration, f func()) Timer {
+	w := &waiter{
+		f: f,
+	}
+	m.add(w, d)
+	return w
+}
+
+func (m *Manual) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	w := &waiter{
+		period: d,
+		ch:     make(chan time.Time, 1),
+	}
+	m.add(w, d)
+	return &manualTicker{
+		waiter: w,
+	}
+}
+
+// BlockUntil waits until n goroutines sleep on the clock, that is called Sleep or wait on After or a timer of NewTimer.
+// A stopped timer is no sleeper anymore.
+// Advancing the clock only after the sensor workers went to sleep again makes every step see all readings of the step before.
+func (m *Manual) BlockUntil(n int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for m.sleepers() < n {
+		m.changed.Wait()
+	}
+}
+
+func (m *Manual) sleepers() int {
+	n := 0
+	for _, w := range m.waiters {
+		if w.sleeper {
+			n++
+		}
+	}
+	return n
+}
+
+// Advance moves the clock forward by d and fires every timer and ticker which is due on the way.
+func (m *Manual) Advance(d time.Duration) {
+	m.mutex.Lock()
+	target := m.now.Add(d)
+	m.mutex.Unlock()
+
+	for m.fireNext(target) {
+	}
+
+	m.mutex.Lock()
+	if m.now.Before(target) {
+		m.now = target
+	}
+	m.mutex.Unlock()
+}
+
+// fireNext fires the earliest waiter due until target and reports whether there was one.
+func (m *Manual) fireNext(target time.Time) bool {
+	m.mutex.Lock()
+
+	if len(m.waiters) == 0 || m.waiters[0].deadline.After(target) {
+		m.mutex.Unlock()
+		return false
+	}
+
+	w := m.waiters[0]
+	m.waiters = m.waiters[1:]
+	m.now = w.deadline
+
+	if w.period > 0 {
+		w.deadline = w.deadline.Add(w.period)
+		m.insert(w)
+	}
+	now := m.now
+	m.mutex.Unlock()
+
+	// like the timers of the time package, functions run in their own goroutine
+	if w.f != nil {
+		go w.f()
+		return true
+	}
+
+	// like the tickers of the time package, ticks a slow receiver misses are dropped
+	select {
+	case w.ch <- now:
+	default:
+	}
+	return true
+}
+
+func (m *Manual) add(w *waiter, d time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	w.clock = m
+	w.deadline = m.now.Add(d)
+	m.insert(w)
+	m.changed.Broadcast()
+}
+
+// insert keeps the waiters sorted by deadline, waiters with the same deadline fire in the order they were added.
+func (m *Manual) insert(w *waiter) {
+	i := sort.Search(len(m.waiters), func(i int) bool {
+		return m.waiters[i].deadline.After(w.deadline)
+	})
+
+	m.waiters = append(m.waiters, nil)
+	copy(m.waiters[i+1:], m.waiters[i:])
+	m.waiters[i] = w
+}
+
+func (w *waiter) C() <-chan time.Time {
+	return w.ch
+}
+
+func (w *waiter) Stop() bool {
+	m := w.clock
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for i, other := range m.waiters {
+		if other == w {
+			m.waiters = append(m.waiters[:i], m.waiters[i+1:]...)
+			m.changed.Broadcast()
+			return true
+		}
+	}
+	return false
+}
+
+type manualTicker struct {
+	waiter *waiter
+}
+
+func (t *manualTicker) C() <-chan time.Time {
+	return t.waiter.ch
+}
+
+func (t *manualTicker) Stop() {
+	t.waiter.Stop()
+}
@@ -0,0 +1,58 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestManualFiresInOrderOfDeadlines(t *testing.T) {
+	start := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	m := NewManual(start)
+
+	fired := make(chan string, 3)
+	m.AfterFunc(2*time.Second, func() { fired <- "func" })
+	timer := m.NewTimer(time.Second)
+	ticker := m.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	m.Advance(time.Second)
+	if at := <-timer.C(); !at.Equal(start.Add(time.Second)) {
+		t.Errorf("timer fired at %s, want after a second", at)
+	}
+	if at := <-ticker.C(); !at.Equal(start.Add(time.Second)) {
+		t.Errorf("ticker ticked at %s, want after a second", at)
+	}
+
+	m.Advance(time.Second)
+	if f := <-fired; f != "func" {
+		t.Errorf("got %s", f)
+	}
+	if at := <-ticker.C(); !at.Equal(start.Add(2 * time.Second)) {
+		t.Errorf("ticker ticked at %s, want after two seconds", at)
+	}
+}
+
+func TestManualStoppedTimerIsNoSleeper(t *testing.T) {
+	m := NewManual(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	timer := m.NewTimer(time.Second)
+	m.After(time.Second)
+	m.BlockUntil(2)
+
+	if !timer.Stop() {
+		t.Error("stopping a pending timer returned false")
+	}
+	if n := m.sleepers(); n != 1 {
+		t.Errorf("clock has %d sleepers after a timer was stopped, want 1", n)
+	}
+
+	m.Advance(time.Second)
+	if n := m.sleepers(); n != 0 {
+		t.Errorf("clock has %d sleepers after the timers fired, want 0", n)
+	}
+	select {
+	case <-timer.C():
+		t.Error("stopped timer fired")
+	default:
+	}
+}
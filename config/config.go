@@ -0,0 +1,359 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/mqtt"
+	"github.com/ZamarianPatrick/lazypig-backend/notify"
+	"gopkg.in/yaml.v2"
+	"gorm.io/gorm/logger"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultConfigFile is read if it exists and no other config file was given.
+const DefaultConfigFile = "config.yml"
+
+const envPrefix = "LAZYPIG_"
+
+const (
+	HardwareFake = "fake"
+	HardwareReal = "real"
+)
+
+// Config is everything needed to start the backend. Each value is taken from, in increasing precedence,
+// the defaults, the config file, the environment variables and the command line flags.
+type Config struct {
+	Listen       string `yaml:"listen"`
+	BasePath     string `yaml:"basePath"`
+	DBFile       string `yaml:"dbFile"`
+	SettingsFile string `yaml:"settingsFile"`
+	LogLevel     string `yaml:"logLevel"`
+	Hardware     string `yaml:"hardware"`
+	// AllowedOrigins is a comma separated list of the origins, like http://localhost:3000, browsers may send requests from.
+	// Requests from the origin the backend is served from are always allowed.
+	AllowedOrigins  string `yaml:"allowedOrigins"`
+	SessionLifetime string `yaml:"sessionLifetime"`
+	// ClockFactor is how many times faster than real time the backend runs, so days of a simulation pass in minutes.
+	// It can only be changed with fake hardware.
+	ClockFactor string `yaml:"clockFactor"`
+	// Alerts can only be configured in the config file
+	Alerts Alerts `yaml:"alerts"`
+	// MQTT can only be configured in the config file
+	MQTT mqtt.Config `yaml:"mqtt"`
+}
+
+// Alerts configure who is notified about alarms and how often.
+type Alerts struct {
+	// RenotifyInterval is how often an alert which is neither acknowledged nor resolved is sent again, 0 sends it once
+	RenotifyInterval string `yaml:"renotifyInterval"`
+	// Kinds are the alarm kinds which are sent, without kinds every kind but COOLDOWN is sent
+	Kinds    []string               `yaml:"kinds"`
+	Webhooks []notify.WebhookConfig `yaml:"webhooks"`
+	SMTP     []notify.SMTPConfig    `yaml:"smtp"`
+	Ntfy     []notify.NtfyConfig    `yaml:"ntfy"`
+	Gotify   []notify.GotifyConfig  `yaml:"gotify"`
+}
+
+func Default() Config {
+	return Config{
+		Listen:          ":8080",
+		BasePath:        "./",
+		DBFile:          "db.sqlite",
+		SettingsFile:    "stationSettings.yml",
+		LogLevel:        "info",
+		Hardware:        HardwareFake,
+		SessionLifetime: "720h",
+		ClockFactor:     "1",
+		Alerts: Alerts{
+			RenotifyInterval: "6h",
+		},
+	}
+}
+
+// option describes how a value of the config is set by flag and environment variable.
+type option struct {
+	flag  string
+	env   string
+	usage string
+	value func(c *Config) *string
+}
+
+var options = []option{
+	{"listen", "LISTEN", "address the http server listens on", func(c *Config) *string { return &c.Listen }},
+	{"base-path", "BASE_PATH", "directory the db and settings file are relative to", func(c *Config) *string { return &c.BasePath }},
+	{"db-file", "DB_FILE", "sqlite database file", func(c *Config) *string { return &c.DBFile }},
+	{"settings-file", "SETTINGS_FILE", "station settings file", func(c *Config) *string { return &c.SettingsFile }},
+	{"log-level", "LOG_LEVEL", "database log level: silent, error, warn or info", func(c *Config) *string { return &c.LogLevel }},
+	{"hardware", "HARDWARE", "fake to simulate the stations, real to use the gpios and i2c bus of a Raspberry Pi", func(c *Config) *string { return &c.Hardware }},
+	{"allowed-origins", "ALLOWED_ORIGINS", "comma separated origins browsers may send requests from", func(c *Config) *string { return &c.AllowedOrigins }},
+	{"session-lifetime", "SESSION_LIFETIME", "how long a login is valid, like 720h", func(c *Config) *string { return &c.SessionLifetime }},
+	{"clock-factor", "CLOCK_FACTOR", "how many times faster than real time the fake hardware runs, like 60", func(c *Config) *string { return &c.ClockFactor }},
+}
+
+// Load builds the config from the command line arguments without the program name, the environment and the config file.
+// The config file is given by -config or LAZYPIG_CONFIG, without one config.yml is read if it exists.
+func Load(args []string) (*Config, error) {
+	fs := flag.NewFlagSet("lazypig", flag.ContinueOnError)
+	configFile := fs.String("config", "", "yaml config file, LAZYPIG_CONFIG")
+
+	flags := make(map[string]*string)
+	for _, o := range options {
+		flags[o.flag] = fs.String(o.flag, "", fmt.Sprintf("%s, %s%s", o.usage, envPrefix, o.env))
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	c := Default()
+
+	path := *configFile
+	if path == "" {
+		path = os.Getenv(envPrefix + "CONFIG")
+	}
+	if path != "" {
+		if err := c.readFile(path); err != nil {
+			return nil, err
+		}
+	} else if _, err := os.Stat(DefaultConfigFile); err == nil {
+		if err = c.readFile(DefaultConfigFile); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, o := range options {
+		if value, ok := os.LookupEnv(envPrefix + o.env); ok {
+			*o.value(&c) = value
+		}
+		if set[o.flag] {
+			*o.value(&c) = *flags[o.flag]
+		}
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// readFile overwrites the values set in the yaml file at path.
+func (c *Config) readFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err = yaml.UnmarshalStrict(data, c); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// Parsed are the values of a config which are given as text, parsed and checked.
+type Parsed struct {
+	LogLevel         logger.LogLevel
+	SessionLifetime  time.Duration
+	ClockSpeed       float64
+	RenotifyInterval time.Duration
+	AlertKinds       []model.AlarmKind
+	Notifiers        []notify.Notifier
+}
+
+func (c *Config) Validate() error {
+	_, err := c.Parse()
+	return err
+}
+
+// Parse checks the config and returns the values which are given as text parsed.
+func (c *Config) Parse() (*Parsed, error) {
+	if c.Listen == "" {
+		return nil, errors.New("listen address must not be empty")
+	}
+	if c.DBFile == "" || c.SettingsFile == "" {
+		return nil, errors.New("db file and settings file must not be empty")
+	}
+
+	switch c.Hardware {
+	case HardwareFake, HardwareReal:
+	default:
+		return nil, fmt.Errorf("hardware must be %s or %s, not %s", HardwareFake, HardwareReal, c.Hardware)
+	}
+
+	var p Parsed
+	var err error
+	if p.LogLevel, err = c.GormLogLevel(); err != nil {
+		return nil, err
+	}
+	if p.SessionLifetime, err = c.SessionDuration(); err != nil {
+		return nil, err
+	}
+
+	if p.ClockSpeed, err = c.ClockSpeed(); err != nil {
+		return nil, err
+	}
+	if p.ClockSpeed != 1 && !c.FakeHardware() {
+		return nil, fmt.Errorf("clock factor must be 1 with %s hardware, not %s", HardwareReal, c.ClockFactor)
+	}
+
+	if p.RenotifyInterval, err = c.Alerts.Renotify(); err != nil {
+		return nil, err
+	}
+	if p.AlertKinds, err = c.Alerts.AlarmKinds(); err != nil {
+		return nil, err
+	}
+	if p.Notifiers, err = c.Alerts.Notifiers(); err != nil {
+		return nil, err
+	}
+
+	if err = c.MQTT.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// FakeHardware reports whether the stations run with fake sensors and actuators.
+func (c *Config) FakeHardware() bool {
+	return c.Hardware != HardwareReal
+}
+
+// Origins returns the allowed origins.
+func (c *Config) Origins() []string {
+	var origins []string
+	for _, origin := range strings.Split(c.AllowedOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, strings.TrimSuffix(origin, "/"))
+		}
+	}
+	return origins
+}
+
+func (c *Config) SessionDuration() (time.Duration, error) {
+	d, err := time.ParseDuration(c.SessionLifetime)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("session lifetime must be a positive duration like 720h, not %s", c.SessionLifetime)
+	}
+	return d, nil
+}
+
+// ClockSpeed returns how many times faster than real time the backend runs.
+func (c *Config) ClockSpeed() (float64, error) {
+	factor, err := strconv.ParseFloat(c.ClockFactor, 64)
+	if err != nil || factor <= 0 || math.IsInf(factor, 0) {
+		return 0, fmt.Errorf("clock factor must be a positive number like 60, not %s", c.ClockFactor)
+	}
+	return factor, nil
+}
+
+func (c *Config) DBPath() string {
+	return c.path(c.DBFile)
+}
+
+func (c *Config) SettingsPath() string {
+	return c.path(c.SettingsFile)
+}
+
+// path resolves file against the base path, absolute files are taken as they are.
+func (c *Config) path(file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(c.BasePath, file)
+}
+
+func (c *Config) GormLogLevel() (logger.LogLevel, error) {
+	switch strings.ToLower(c.LogLevel) {
+	case "silent":
+		return logger.Silent, nil
+	case "error":
+		return logger.Error, nil
+	case "warn":
+		return logger.Warn, nil
+	case "info":
+		return logger.Info, nil
+	}
+	return 0, fmt.Errorf("log level must be silent, error, warn or info, not %s", c.LogLevel)
+}
+
+func (a *Alerts) Renotify() (time.Duration, error) {
+	if a.RenotifyInterval == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(a.RenotifyInterval)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("alert renotify interval must be a duration like 6h, not %s", a.RenotifyInterval)
+	}
+	return d, nil
+}
+
+// AlarmKinds returns the kinds of alarms which are sent.
+func (a *Alerts) AlarmKinds() ([]model.AlarmKind, error) {
+	if len(a.Kinds) == 0 {
+		var kinds []model.AlarmKind
+		for _, kind := range model.AllAlarmKind {
+			if kind != model.AlarmKindCooldown {
+				kinds = append(kinds, kind)
+			}
+		}
+		return kinds, nil
+	}
+
+	kinds := make([]model.AlarmKind, len(a.Kinds))
+	for i, k := range a.Kinds {
+		kinds[i] = model.AlarmKind(strings.ToUpper(k))
+		if !kinds[i].IsValid() {
+			return nil, fmt.Errorf("alerts: %s is not an alarm kind", k)
+		}
+	}
+	return kinds, nil
+}
+
+// Notifiers creates the notifiers of all configured webhooks, mail servers and push services.
+func (a *Alerts) Notifiers() ([]notify.Notifier, error) {
+	var notifiers []notify.Notifier
+	add := func(n notify.Notifier, err error) error {
+		if err != nil {
+			return fmt.Errorf("alerts: %w", err)
+		}
+		notifiers = append(notifiers, n)
+		return nil
+	}
+
+	for _, c := range a.Webhooks {
+		if err := add(notify.NewWebhook(c)); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range a.SMTP {
+		if err := add(notify.NewSMTP(c)); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range a.Ntfy {
+		if err := add(notify.NewNtfy(c)); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range a.Gotify {
+		if err := add(notify.NewGotify(c)); err != nil {
+			return nil, err
+		}
+	}
+
+	return notifiers, nil
+}
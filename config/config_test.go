@@ -0,0 +1,103 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := writeConfig(t, `
+listen: ":9000"
+dbFile: file.sqlite
+logLevel: warn
+sessionLifetime: 24h
+`)
+	t.Setenv(envPrefix+"CONFIG", path)
+	t.Setenv(envPrefix+"DB_FILE", "env.sqlite")
+	t.Setenv(envPrefix+"LOG_LEVEL", "error")
+
+	c, err := Load([]string{"-log-level", "silent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"settings file of the defaults", c.SettingsFile, Default().SettingsFile},
+		{"listen address of the file", c.Listen, ":9000"},
+		{"session lifetime of the file", c.SessionLifetime, "24h"},
+		{"db file of the environment", c.DBFile, "env.sqlite"},
+		{"log level of the flag", c.LogLevel, "silent"},
+	} {
+		if v.got != v.want {
+			t.Errorf("got %s %q, want %q", v.name, v.got, v.want)
+		}
+	}
+}
+
+func TestLoadConfigFileOfTheFlag(t *testing.T) {
+	t.Setenv(envPrefix+"CONFIG", writeConfig(t, "listen: \":9000\"\n"))
+
+	c, err := Load([]string{"-config", writeConfig(t, "listen: \":9100\"\n")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Listen != ":9100" {
+		t.Errorf("listen address is %q, want the one of the file given by the flag", c.Listen)
+	}
+}
+
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	_, err := Load([]string{"-config", writeConfig(t, "listen: \":9000\"\nlistenAddress: \":9100\"\n")})
+	if err == nil || !strings.Contains(err.Error(), "listenAddress") {
+		t.Errorf("loading a config with an unknown key returned %v, want an error about the key", err)
+	}
+}
+
+func TestLoadRejectsInvalidValues(t *testing.T) {
+	for _, args := range [][]string{
+		{"-log-level", "verbose"},
+		{"-hardware", "arduino"},
+		{"-session-lifetime", "-1h"},
+		{"-clock-factor", "0"},
+		{"-clock-factor", "60", "-hardware", "real"},
+	} {
+		if _, err := Load(append([]string{"-config", writeConfig(t, "")}, args...)); err == nil {
+			t.Errorf("loading with %v succeeded", args)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	c := Default()
+	c.LogLevel = "warn"
+	c.ClockFactor = "60"
+	c.Alerts.Kinds = []string{"max_open_time"}
+
+	p, err := c.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.SessionLifetime != 720*time.Hour || p.RenotifyInterval != 6*time.Hour || p.ClockSpeed != 60 {
+		t.Errorf("got %+v, want the durations of the defaults and a clock speed of 60", p)
+	}
+	if len(p.AlertKinds) != 1 || p.AlertKinds[0] != "MAX_OPEN_TIME" {
+		t.Errorf("got alert kinds %v, want MAX_OPEN_TIME", p.AlertKinds)
+	}
+}
@@ -0,0 +1,311 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/notify"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	alertCheckInterval = time.Minute
+	// notificationQueueSize is the number of notifications which wait for slow notifiers before new ones are dropped
+	notificationQueueSize = 64
+)
+
+// conditionKinds are the kinds of alerts about conditions, which resolve once their condition goes away. Alerts of
+// every other kind are about single events, like a valve open for too long, and are resolved once acknowledged,
+// so the next such event is sent again.
+var conditionKinds = []model.AlarmKind{
+	model.AlarmKindReservoirLow,
+	model.AlarmKindSensorFault,
+	model.AlarmKindThirstyWithoutWater,
+}
+
+func isConditionKind(kind model.AlarmKind) bool {
+	for _, k := range conditionKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func alertKey(stationID uint64, port string, kind model.AlarmKind) string {
+	return fmt.Sprintf("%d/%s/%s", stationID, port, kind)
+}
+
+// raiseAlert adds the alarm to its open alert, or opens a new alert and sends it if there is none.
+// Alerts which are open already are only sent again by sendDueAlerts, so repeated alarms do not flood anybody.
+func (c *controller) raiseAlert(alarm *model.Alarm) {
+	if !c.alertKinds[alarm.Kind] {
+		return
+	}
+
+	key := alertKey(alarm.StationID, alarm.Port, alarm.Kind)
+
+	var alert model.Alert
+	res := c.db.Where("dedup_key = ? AND resolved_at IS NULL", key).Limit(1).Find(&alert)
+	if res.Error != nil {
+		log.Println("could not load alert", res.Error)
+		return
+	}
+
+	if res.RowsAffected > 0 {
+		alert.Count++
+		alert.LastRaisedAt = alarm.CreatedAt
+		alert.Message = alarm.Message
+		alert.PlantID = alarm.PlantID
+		if res := c.db.Save(&alert); res.Error != nil {
+			log.Println("could not store alert", res.Error)
+		}
+		return
+	}
+
+	alert = model.Alert{
+		DedupKey:      key,
+		StationID:     alarm.StationID,
+		PlantID:       alarm.PlantID,
+		Port:          alarm.Port,
+		Kind:          alarm.Kind,
+		Message:       alarm.Message,
+		Count:         1,
+		FirstRaisedAt: alarm.CreatedAt,
+		LastRaisedAt:  alarm.CreatedAt,
+	}
+	if res := c.db.Create(&alert); res.Error != nil {
+		log.Println("could not store alert", res.Error)
+		return
+	}
+
+	c.sendAlert(&alert)
+}
+
+// resolveAlert closes the open alert of a condition which does not hold anymore.
+// People who got the alert are told that it is resolved.
+func (c *controller) resolveAlert(stationID uint64, port string, kind model.AlarmKind) {
+	var alert model.Alert
+	res := c.db.Where("dedup_key = ? AND resolved_at IS NULL", alertKey(stationID, port, kind)).Limit(1).Find(&alert)
+	if res.Error != nil {
+		log.Println("could not load alert", res.Error)
+		return
+	}
+	if res.RowsAffected == 0 {
+		return
+	}
+
+	now := c.clock.Now().UTC()
+	alert.ResolvedAt = &now
+	if res := c.db.Save(&alert); res.Error != nil {
+		log.Println("could not store alert", res.Error)
+		return
+	}
+
+	if alert.LastNotifiedAt != nil {
+		c.notify(&alert)
+	}
+}
+
+// sendAlert queues the notification of the alert and remembers when it was sent.
+func (c *controller) sendAlert(alert *model.Alert) {
+	if len(c.notifiers) == 0 {
+		return
+	}
+
+	now := c.clock.Now().UTC()
+	alert.LastNotifiedAt = &now
+	if res := c.db.Model(alert).Update("last_notified_at", now); res.Error != nil {
+		log.Println("could not store alert", res.Error)
+	}
+
+	c.notify(alert)
+}
+
+// notify queues the notification without waiting for the notifiers, a station loop must never wait for a mail server.
+func (c *controller) notify(alert *model.Alert) {
+	if len(c.notifiers) == 0 {
+		return
+	}
+
+	n := notification(c.stationName(alert.StationID), alert)
+	select {
+	case c.notifications <- n:
+	default:
+		log.Println("notifications are queued up, dropping", n.Title)
+	}
+}
+
+func notification(stationName string, alert *model.Alert) notify.Notification {
+	subject := stationName
+	if alert.Port != "" {
+		subject += " port " + alert.Port
+	}
+	kind := strings.ToLower(strings.ReplaceAll(alert.Kind.String(), "_", " "))
+
+	n := notify.Notification{
+		AlertID:   alert.ID,
+		StationID: alert.StationID,
+		Port:      alert.Port,
+		Kind:      alert.Kind.String(),
+		Title:     fmt.Sprintf("%s: %s", subject, kind),
+		Message:   alert.Message,
+		Time:      alert.LastRaisedAt,
+	}
+	if alert.Count > 1 {
+		n.Message += fmt.Sprintf(" (%d times since %s)", alert.Count, alert.FirstRaisedAt.Local().Format("2006-01-02 15:04"))
+	}
+	if alert.ResolvedAt != nil {
+		n.Resolved = true
+		n.Title = "Resolved: " + n.Title
+		n.Time = *alert.ResolvedAt
+	}
+
+	return n
+}
+
+func (c *controller) stationName(stationID uint64) string {
+	var station model.Station
+	if res := c.db.Limit(1).Find(&station, stationID); res.Error != nil || res.RowsAffected == 0 {
+		return fmt.Sprintf("Station %d", stationID)
+	}
+	return station.Name
+}
+
+// deliverNotifications hands every queued notification to all notifiers, one after the other.
+func (c *controller) deliverNotifications() {
+	for n := range c.notifications {
+		for _, notifier := range c.notifiers {
+			ctx, cancel := context.WithTimeout(context.Background(), notify.Timeout)
+			if err := notifier.Notify(ctx, n); err != nil {
+				log.Println("could not notify", notifier.Name(), err)
+			}
+			cancel()
+		}
+	}
+}
+
+// watchAlerts sends the alerts again which are neither acknowledged nor resolved once the renotify interval passed.
+func (c *controller) watchAlerts() {
+	ticker := c.clock.NewTicker(alertCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C() {
+		c.sendDueAlerts()
+	}
+}
+
+func (c *controller) sendDueAlerts() {
+	if c.renotifyInterval <= 0 {
+		return
+	}
+
+	var alerts []*model.Alert
+	res := c.db.
+		Where("resolved_at IS NULL AND acknowledged_at IS NULL AND last_notified_at <= ?", c.clock.Now().UTC().Add(-c.renotifyInterval)).
+		Find(&alerts)
+	if res.Error != nil {
+		log.Println("could not load alerts", res.Error)
+		return
+	}
+
+	for _, alert := range alerts {
+		c.sendAlert(alert)
+	}
+}
+
+// AcknowledgeAlert stops sending the alert again. Alerts about conditions still resolve when their condition goes away,
+// alerts about events are resolved right away.
+func (c *controller) AcknowledgeAlert(id uint64, by string) (*model.Alert, error) {
+	var alert model.Alert
+	if err := findByID(c.db, &alert, "alert", id); err != nil {
+		return nil, err
+	}
+
+	if alert.AcknowledgedAt != nil {
+		return &alert, nil
+	}
+
+	now := c.clock.Now().UTC()
+	alert.AcknowledgedAt = &now
+	alert.AcknowledgedBy = &by
+	if !isConditionKind(alert.Kind) {
+		alert.ResolvedAt = &now
+	}
+	if res := c.db.Save(&alert); res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &alert, nil
+}
+
+// alertCondition is a condition of a station which raises an alert while it holds and resolves it once it does not.
+type alertCondition struct {
+	state   *plantState
+	kind    model.AlarmKind
+	holds   bool
+	message string
+}
+
+// checkAlertConditions raises and resolves the alerts of conditions which changed since the last check.
+// Conditions are checked once after a start, so alerts which went away while the station was stopped are resolved.
+func (s *station) checkAlertConditions() {
+	waterLevelKnown := s.lastWaterLevel >= 0 && !s.sensorFaulted(sensors.WaterLevelSensorName, "")
+
+	conditions := []alertCondition{{
+		kind:    model.AlarmKindReservoirLow,
+		holds:   waterLevelKnown && s.lastWaterLevel < s.settings.ReservoirAlertLevel,
+		message: fmt.Sprintf("water level %.0f%% is below %.0f%%, please refill the reservoir", s.lastWaterLevel, s.settings.ReservoirAlertLevel),
+	}}
+
+	s.healthMutex.RLock()
+	if health, ok := s.health[sensorKey(sensors.WaterLevelSensorName, "")]; ok {
+		conditions = append(conditions, alertCondition{
+			kind:    model.AlarmKindSensorFault,
+			holds:   health.Faulted(),
+			message: fmt.Sprintf("water level sensor is faulted, %s", health.Message()),
+		})
+	}
+	for _, state := range s.plantStates {
+		if health, ok := s.health[sensorKey(sensors.MoistureSensorName, state.port.Port)]; ok {
+			conditions = append(conditions, alertCondition{
+				state:   state,
+				kind:    model.AlarmKindSensorFault,
+				holds:   health.Faulted(),
+				message: fmt.Sprintf("moisture sensor is faulted, %s", health.Message()),
+			})
+		}
+	}
+	s.healthMutex.RUnlock()
+
+	for _, state := range s.plantStates {
+		conditions = append(conditions, alertCondition{
+			state:   state,
+			kind:    model.AlarmKindThirstyWithoutWater,
+			holds:   waterLevelKnown && state.thirsty && s.lastWaterLevel < s.settings.MinWaterLevel,
+			message: fmt.Sprintf("plant is thirsty at %.1f but the water level %.0f%% is below %.0f%%", state.currentMoisture, s.lastWaterLevel, s.settings.MinWaterLevel),
+		})
+	}
+
+	for _, condition := range conditions {
+		port := ""
+		if condition.state != nil {
+			port = condition.state.port.Port
+		}
+
+		key := alertKey(s.id, port, condition.kind)
+		if held, checked := s.alertConditions[key]; checked && held == condition.holds {
+			continue
+		}
+		s.alertConditions[key] = condition.holds
+
+		if condition.holds {
+			s.raiseAlarm(condition.state, condition.kind, condition.message)
+		} else {
+			s.controller.resolveAlert(s.id, port, condition.kind)
+		}
+	}
+}
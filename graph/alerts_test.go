@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"context"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/notify"
+	"strings"
+	"testing"
+	"time"
+)
+
+// recordingNotifier hands the notifications to the test instead of sending them.
+type recordingNotifier chan notify.Notification
+
+func (r recordingNotifier) Name() string {
+	return "recording"
+}
+
+func (r recordingNotifier) Notify(_ context.Context, n notify.Notification) error {
+	r <- n
+	return nil
+}
+
+// expect waits for the next notification, notifications are delivered outside of the station loop.
+func (r recordingNotifier) expect(t *testing.T, title string) notify.Notification {
+	t.Helper()
+
+	select {
+	case n := <-r:
+		if !strings.Contains(n.Title, title) {
+			t.Errorf("got notification %q, want one about %q", n.Title, title)
+		}
+		return n
+	case <-time.After(5 * time.Second):
+		t.Fatalf("got no notification about %q", title)
+	}
+	return notify.Notification{}
+}
+
+func (r recordingNotifier) expectNone(t *testing.T) {
+	t.Helper()
+
+	select {
+	case n := <-r:
+		t.Errorf("got notification %q, want none", n.Title)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func (tc *testController) alerts(kind model.AlarmKind) []*model.Alert {
+	tc.t.Helper()
+
+	var alerts []*model.Alert
+	if err := tc.db.Where("kind = ?", kind).Order("id").Find(&alerts).Error; err != nil {
+		tc.t.Fatal(err)
+	}
+	return alerts
+}
+
+func TestRepeatedEventsAreOneAlertUntilAcknowledged(t *testing.T) {
+	notifier := make(recordingNotifier, 16)
+	settings := testStation(1, false)
+	settings.Ports[0].MaxOpenSeconds = 2
+	settings.Ports[0].CooldownSeconds = 0
+	tc := newTestControllerWithOptions(t, Options{Notifiers: []notify.Notifier{notifier}}, settings)
+
+	tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	tc.setMoisture(1, "A", 30)
+	for i := 0; i < 10; i++ {
+		tc.advance(time.Second)
+	}
+
+	alarms := tc.alarms(1, model.AlarmKindMaxOpenTime)
+	alerts := tc.alerts(model.AlarmKindMaxOpenTime)
+	if len(alarms) < 2 || len(alerts) != 1 || alerts[0].Count != len(alarms) {
+		t.Fatalf("got %d max open time alarms and alerts %+v, want all alarms counted by a single alert", len(alarms), alerts)
+	}
+	notifier.expect(t, "max open time")
+	notifier.expectNone(t)
+
+	acknowledged, err := tc.AcknowledgeAlert(alerts[0].ID, "gardener")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acknowledged.ResolvedAt == nil {
+		t.Error("acknowledged alert about an event is not resolved")
+	}
+
+	// the next time the valve is open for too long is a new alert
+	for i := 0; i < 5; i++ {
+		tc.advance(time.Second)
+	}
+	if alerts = tc.alerts(model.AlarmKindMaxOpenTime); len(alerts) != 2 || alerts[1].ResolvedAt != nil {
+		t.Fatalf("got alerts %+v, want a second open alert", alerts)
+	}
+	notifier.expect(t, "max open time")
+}
+
+func TestConditionAlertsResolveWhenTheConditionIsGone(t *testing.T) {
+	notifier := make(recordingNotifier, 16)
+	tc := newTestControllerWithOptions(t, Options{Notifiers: []notify.Notifier{notifier}}, testStation(1, false))
+
+	tc.setWaterLevel(1, 10)
+	tc.advance(time.Second)
+	alerts := tc.alerts(model.AlarmKindReservoirLow)
+	if len(alerts) != 1 || alerts[0].ResolvedAt != nil {
+		t.Fatalf("got alerts %+v, want an open reservoir low alert", alerts)
+	}
+	notifier.expect(t, "reservoir low")
+
+	// the reservoir is still low after the acknowledgement, the alert stays open but is not sent again
+	acknowledged, err := tc.AcknowledgeAlert(alerts[0].ID, "gardener")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acknowledged.ResolvedAt != nil {
+		t.Error("acknowledged alert about a condition which still holds is resolved")
+	}
+	tc.advance(time.Second)
+	notifier.expectNone(t)
+
+	tc.setWaterLevel(1, 80)
+	tc.advance(time.Second)
+	if alerts = tc.alerts(model.AlarmKindReservoirLow); len(alerts) != 1 || alerts[0].ResolvedAt == nil {
+		t.Fatalf("got alerts %+v, want the alert resolved", alerts)
+	}
+	if n := notifier.expect(t, "Resolved: "); !n.Resolved {
+		t.Error("notification about the resolved alert is not marked resolved")
+	}
+
+	tc.setWaterLevel(1, 10)
+	tc.advance(time.Second)
+	if alerts = tc.alerts(model.AlarmKindReservoirLow); len(alerts) != 2 || alerts[1].ResolvedAt != nil {
+		t.Fatalf("got alerts %+v, want a second open alert", alerts)
+	}
+	notifier.expect(t, "reservoir low")
+}
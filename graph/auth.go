@@ -0,0 +1,259 @@
+package graph
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/ZamarianPatrick/lazypig-backend/auth"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/generated"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"strings"
+	"time"
+)
+
+const DefaultSessionLifetime = 30 * 24 * time.Hour
+
+// identityCheckInterval is how often a websocket checks that its session or API key is still valid.
+const identityCheckInterval = 30 * time.Second
+
+// Authenticate returns the context carrying the identity of a session token or API key.
+func (r *Resolver) Authenticate(ctx context.Context, token string) (context.Context, error) {
+	identity, err := r.identity(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.WithIdentity(ctx, identity), nil
+}
+
+func (r *Resolver) identity(token string) (*auth.Identity, error) {
+	db := r.controller.DB()
+	hash := auth.HashToken(token)
+	now := r.clock.Now()
+
+	identity := &auth.Identity{}
+	var userID uint64
+
+	switch {
+	case strings.HasPrefix(token, auth.SessionPrefix):
+		var session model.Session
+		res := db.Where("token_hash = ? AND expires_at > ?", hash, now).Limit(1).Find(&session)
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil, unauthenticated("the session is invalid or expired")
+		}
+
+		identity.SessionID = session.ID
+		userID = session.UserID
+	case strings.HasPrefix(token, auth.APIKeyPrefix):
+		var key model.APIKey
+		res := db.Where("key_hash = ?", hash).Limit(1).Find(&key)
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil, unauthenticated("the API key is invalid or revoked")
+		}
+
+		db.Model(&key).Update("last_used_at", now)
+		identity.APIKeyID = key.ID
+		identity.Role = key.Role
+		userID = key.UserID
+	default:
+		return nil, unauthenticated("the token is neither a session token nor an API key")
+	}
+
+	if err := r.loadUser(identity, userID); err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+// loadUser sets the user of the identity and lowers the role of an API key to the role of its user.
+func (r *Resolver) loadUser(identity *auth.Identity, userID uint64) error {
+	res := r.controller.DB().Limit(1).Find(&identity.User, userID)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return unauthenticated("the user of the token does not exist anymore")
+	}
+
+	if identity.Role == "" || !identity.User.Role.Includes(identity.Role) {
+		identity.Role = identity.User.Role
+	}
+	return nil
+}
+
+// refresh loads the identity again, so a websocket which authenticated long ago notices
+// that its session ended, its API key was revoked or its role was changed.
+func (r *Resolver) refresh(identity *auth.Identity) (*auth.Identity, error) {
+	db := r.controller.DB()
+	fresh := &auth.Identity{
+		SessionID: identity.SessionID,
+		APIKeyID:  identity.APIKeyID,
+	}
+
+	switch {
+	case identity.SessionID != 0:
+		var sessions int64
+		res := db.Model(&model.Session{}).Where("id = ? AND expires_at > ?", identity.SessionID, r.clock.Now()).Count(&sessions)
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		if sessions == 0 {
+			return nil, unauthenticated("the session is invalid or expired")
+		}
+	case identity.APIKeyID != 0:
+		var key model.APIKey
+		res := db.Limit(1).Find(&key, identity.APIKeyID)
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil, unauthenticated("the API key is invalid or revoked")
+		}
+		fresh.Role = key.Role
+	}
+
+	if err := r.loadUser(fresh, identity.User.ID); err != nil {
+		return nil, err
+	}
+	return fresh, nil
+}
+
+// WebsocketInit authenticates a websocket by the authorization of its connection_init payload.
+// Without one the identity of the Authorization header of the upgrade request is kept.
+// The websocket is closed once the session or API key is not valid anymore or the role of the user was lowered.
+func (r *Resolver) WebsocketInit(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+	token := auth.BearerToken(payload.Authorization())
+	if token == "" && auth.ForContext(ctx) == nil {
+		return nil, unauthenticated("the connection_init payload needs an authorization")
+	}
+
+	if token != "" {
+		var err error
+		if ctx, err = r.Authenticate(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	go r.watchIdentity(ctx, cancel, auth.ForContext(ctx))
+
+	return ctx, nil
+}
+
+// watchIdentity cancels the context of a websocket, which closes it, once its identity is not valid anymore.
+func (r *Resolver) watchIdentity(ctx context.Context, cancel context.CancelFunc, identity *auth.Identity) {
+	ticker := r.clock.NewTicker(identityCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			fresh, err := r.refresh(identity)
+			if err != nil || !fresh.Role.Includes(identity.Role) {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// RequireAuthentication is a field middleware refusing queries, mutations and subscriptions without @public
+// to requests which were not authenticated. Subscriptions run on websockets which authenticated at their start,
+// so their identity is loaded again for every subscription.
+func (r *Resolver) RequireAuthentication(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return next(ctx)
+	}
+
+	switch fc.Object {
+	case "Query", "Mutation", "Subscription":
+	default:
+		return next(ctx)
+	}
+
+	if identity := auth.ForContext(ctx); identity != nil {
+		if fc.Object == "Subscription" {
+			fresh, err := r.refresh(identity)
+			if err != nil {
+				return nil, err
+			}
+			ctx = auth.WithIdentity(ctx, fresh)
+		}
+		return next(ctx)
+	}
+
+	if strings.HasPrefix(fc.Field.Name, "__") || fc.Field.Definition.Directives.ForName("public") != nil {
+		return next(ctx)
+	}
+
+	return nil, unauthenticated("%s needs a session token or an API key", fc.Field.Name)
+}
+
+// Directives implements the directives of the schema. @public only marks fields for RequireAuthentication.
+func (r *Resolver) Directives() generated.DirectiveRoot {
+	return generated.DirectiveRoot{
+		Public: func(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+			return next(ctx)
+		},
+		HasRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
+			if err := requireRole(ctx, role); err != nil {
+				return nil, err
+			}
+			return next(ctx)
+		},
+		FakeHardware: func(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+			if !r.controller.FakeValues() {
+				return nil, forbidden("%s is only available while the stations run with fake hardware", graphql.GetFieldContext(ctx).Field.Name)
+			}
+			return next(ctx)
+		},
+	}
+}
+
+// requireRole refuses requests whose role does not include the role.
+func requireRole(ctx context.Context, role model.Role) error {
+	identity, err := identityOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !identity.Role.Includes(role) {
+		name := "the request"
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			name = fc.Field.Name
+		}
+		return forbidden("%s needs the role %s, the request has %s", name, role, identity.Role)
+	}
+	return nil
+}
+
+// identityOf returns the identity of the request, resolvers behind RequireAuthentication always have one.
+func identityOf(ctx context.Context) (*auth.Identity, error) {
+	identity := auth.ForContext(ctx)
+	if identity == nil {
+		return nil, unauthenticated("the request is not authenticated")
+	}
+	return identity, nil
+}
+
+// keepAnAdmin refuses to take the role of an admin away if it is the last one.
+func (r *Resolver) keepAnAdmin() error {
+	var admins int64
+	res := r.controller.DB().Model(&model.User{}).Where("role = ?", model.RoleAdmin).Count(&admins)
+	if res.Error != nil {
+		return res.Error
+	}
+	if admins <= 1 {
+		return conflict("the last admin can not be removed")
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/ZamarianPatrick/lazypig-backend/auth"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/generated"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"sync"
+	"testing"
+)
+
+// server runs the schema with the directives, the authentication middleware and the error presenter of the backend.
+func (tc *testController) server() *handler.Server {
+	resolver := &Resolver{controller: tc.controller, clock: tc.clock}
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  resolver,
+		Directives: resolver.Directives(),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.SetErrorPresenter(ErrorPresenter)
+	srv.AroundFields(resolver.RequireAuthentication)
+	return srv
+}
+
+// asRole authenticates a request with the role, like a session of a user with the role would.
+func asRole(role model.Role) client.Option {
+	return func(r *client.Request) {
+		r.HTTP = r.HTTP.WithContext(auth.WithIdentity(r.HTTP.Context(), &auth.Identity{Role: role}))
+	}
+}
+
+// errorCodes returns the codes in the extensions of the errors of a response.
+func errorCodes(t *testing.T, response *client.Response) []string {
+	t.Helper()
+
+	var errors []struct {
+		Extensions struct {
+			Code string `json:"code"`
+		} `json:"extensions"`
+	}
+	if response.Errors != nil {
+		if err := json.Unmarshal(response.Errors, &errors); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	codes := make([]string, len(errors))
+	for i, e := range errors {
+		codes[i] = e.Extensions.Code
+	}
+	return codes
+}
+
+func TestOnlyOneConcurrentFirstUserBecomesAdmin(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	r := &mutationResolver{&Resolver{controller: tc.controller, clock: tc.clock}}
+
+	var wg sync.WaitGroup
+	users := make(chan *model.User, 8)
+	for i := 0; i < cap(users); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			user, err := r.CreateUser(context.Background(), fmt.Sprintf("gardener%d", i), "correct horse battery", nil)
+			if err == nil {
+				users <- user
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(users)
+
+	created := 0
+	for user := range users {
+		created++
+		if user.Role != model.RoleAdmin {
+			t.Errorf("first user %s has role %s, want %s", user.Username, user.Role, model.RoleAdmin)
+		}
+	}
+	if created != 1 {
+		t.Errorf("%d users were created without being logged in, want 1", created)
+	}
+
+	var stored int64
+	tc.db.Model(&model.User{}).Count(&stored)
+	if stored != 1 {
+		t.Errorf("%d users are stored, want 1", stored)
+	}
+}
+
+func TestLoginDoesNotTellWhichUsersExist(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	r := &mutationResolver{&Resolver{controller: tc.controller, clock: tc.clock}}
+	ctx := context.Background()
+
+	if _, err := r.CreateUser(ctx, "gardener", "correct horse battery", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Login(ctx, "gardener", "correct horse battery"); err != nil {
+		t.Fatalf("login with the right password failed: %v", err)
+	}
+
+	_, wrongPassword := r.Login(ctx, "gardener", "wrong horse battery")
+	_, unknownUser := r.Login(ctx, "weeder", "correct horse battery")
+	if wrongPassword == nil {
+		t.Fatal("login with a wrong password succeeded")
+	}
+	for _, err := range []error{wrongPassword, unknownUser} {
+		if Code(err) != ErrorCodeUnauthenticated || err.Error() != wrongPassword.Error() {
+			t.Errorf("failed login returned %v, want the same unauthenticated error for both", err)
+		}
+	}
+}
+
+func TestViewerCanNotUseMutationsOfGardeners(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	c := client.New(tc.server())
+	mutation := `mutation { createPlantTemplate(input: {name: "Herbs", waterThreshold: 40}) { id } }`
+
+	response, err := c.RawPost(mutation, asRole(model.RoleViewer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codes := errorCodes(t, response); len(codes) != 1 || codes[0] != string(ErrorCodeForbidden) {
+		t.Errorf("viewer got errors %s, want %s", response.Errors, ErrorCodeForbidden)
+	}
+	var templates int64
+	tc.db.Model(&model.PlantTemplate{}).Count(&templates)
+	if templates != 0 {
+		t.Fatalf("%d templates were created by a viewer, want none", templates)
+	}
+
+	// queries stay open to viewers
+	var templatesResponse struct{ Templates []struct{ ID uint64 } }
+	if err = c.Post(`{ templates { id } }`, &templatesResponse, asRole(model.RoleViewer)); err != nil {
+		t.Errorf("viewer could not query the templates: %v", err)
+	}
+
+	var created struct{ CreatePlantTemplate struct{ ID uint64 } }
+	if err = c.Post(mutation, &created, asRole(model.RoleGardener)); err != nil {
+		t.Fatalf("gardener could not create a template: %v", err)
+	}
+	tc.db.Model(&model.PlantTemplate{}).Count(&templates)
+	if templates != 1 {
+		t.Errorf("%d templates are stored after the gardener created one, want 1", templates)
+	}
+}
@@ -0,0 +1,153 @@
+package graph
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+)
+
+func (c *controller) Calibration(stationID uint64, port string) (sensors.Calibration, error) {
+	sensor, err := c.moistureSensor(stationID, port)
+	if err != nil {
+		return sensors.Calibration{}, err
+	}
+
+	return sensor.Calibration(), nil
+}
+
+// CaptureCalibration reads the raw value of the moisture sensor right now and stores it as dry or wet point of the port.
+func (c *controller) CaptureCalibration(stationID uint64, port string, target model.CalibrationTarget) (sensors.Calibration, error) {
+	raw, calibration, err := c.readMoisture(stationID, port)
+	if err != nil {
+		return sensors.Calibration{}, err
+	}
+
+	switch target {
+	case model.CalibrationTargetDry:
+		calibration.Dry = raw
+	case model.CalibrationTargetWet:
+		calibration.Wet = raw
+	}
+
+	return calibration, c.saveCalibration(stationID, port, calibration)
+}
+
+// SetCalibrationCurve replaces the calibration curve of the port, an empty curve falls back to the dry and wet points.
+func (c *controller) SetCalibrationCurve(stationID uint64, port string, points []sensors.CalibrationPoint) (sensors.Calibration, error) {
+	sensor, err := c.moistureSensor(stationID, port)
+	if err != nil {
+		return sensors.Calibration{}, err
+	}
+
+	calibration := sensor.Calibration()
+	calibration.Points = points
+
+	return calibration, c.saveCalibration(stationID, port, calibration)
+}
+
+// ReadRawMoisture reads the moisture sensor of the port and returns the raw value with the percentage it is calibrated to.
+func (c *controller) ReadRawMoisture(stationID uint64, port string) (uint16, float64, error) {
+	raw, calibration, err := c.readMoisture(stationID, port)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return raw, calibration.Percentage(raw), nil
+}
+
+// readMoisture reads the raw value of the moisture sensor of the port in the loop of the station, like the readings
+// of the sensor worker are handled, and returns it with the calibration of the sensor.
+func (c *controller) readMoisture(stationID uint64, port string) (uint16, sensors.Calibration, error) {
+	s, err := c.station(stationID)
+	if err != nil {
+		return 0, sensors.Calibration{}, err
+	}
+
+	sensor, ok := s.moistureSensors[port]
+	if !ok {
+		return 0, sensors.Calibration{}, notFound("station %d has no moisture sensor on port %s", stationID, port)
+	}
+
+	var raw uint16
+	var calibration sensors.Calibration
+	read := make(chan error, 1)
+	if !s.post(func() {
+		var err error
+		raw, err = sensor.ReadRaw()
+		calibration = sensor.Calibration()
+		read <- err
+	}) {
+		return 0, sensors.Calibration{}, conflict("station %d was stopped", stationID)
+	}
+
+	if err = <-read; err != nil {
+		return 0, sensors.Calibration{}, withCode(ErrorCodeHardware, err)
+	}
+	return raw, calibration, nil
+}
+
+func (c *controller) moistureSensor(stationID uint64, port string) (sensors.CalibratedSensor, error) {
+	s, err := c.station(stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	sensor, ok := s.moistureSensors[port]
+	if !ok {
+		return nil, notFound("station %d has no moisture sensor on port %s", stationID, port)
+	}
+
+	return sensor, nil
+}
+
+// saveCalibration changes the calibration of the port in a copy of the settings and applies it like any other change
+// of the settings, the stations run with the settings they were built with until then.
+func (c *controller) saveCalibration(stationID uint64, port string, calibration sensors.Calibration) error {
+	if err := calibration.Validate(); err != nil {
+		return withCode(ErrorCodeValidation, err)
+	}
+
+	c.settingsMutex.Lock()
+	defer c.settingsMutex.Unlock()
+
+	// the port is looked up again while holding the settings, so a reload in between can not swallow the calibration
+	if _, err := c.moistureSensor(stationID, port); err != nil {
+		return err
+	}
+
+	settings := copySettings(c.settings)
+	for i := range settings.Stations {
+		if settings.Stations[i].StationID != stationID {
+			continue
+		}
+
+		for j := range settings.Stations[i].Ports {
+			if settings.Stations[i].Ports[j].Port == port {
+				settings.Stations[i].Ports[j].Calibration = calibration
+			}
+		}
+	}
+
+	if err := c.applySettings(&settings); err != nil {
+		return err
+	}
+
+	return c.saveSettings()
+}
+
+func portCalibration(stationID uint64, port string, calibration sensors.Calibration) *model.PortCalibration {
+	points := make([]*model.CalibrationCurvePoint, len(calibration.Points))
+	for i, p := range calibration.Points {
+		points[i] = &model.CalibrationCurvePoint{
+			Raw:        int(p.Raw),
+			Percentage: p.Percentage,
+		}
+	}
+
+	return &model.PortCalibration{
+		StationID: stationID,
+		Port:      port,
+		Dry:       int(calibration.Dry),
+		Wet:       int(calibration.Wet),
+		Points:    points,
+	}
+}
@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"testing"
+	"time"
+)
+
+func TestCapturedCalibrationIsAppliedAndSaved(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	before := tc.StationSettings()
+
+	// the fake reports its moisture with the default calibration, 20% are a raw value of 1800
+	tc.setMoisture(1, "A", 20)
+	calibration, err := tc.CaptureCalibration(1, "A", model.CalibrationTargetDry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calibration.Dry != 1800 || calibration.Wet != sensors.DefaultCalibration.Wet {
+		t.Fatalf("got calibration %+v, want dry at 1800", calibration)
+	}
+	tc.advance(time.Second)
+
+	tc.setMoisture(1, "A", 60)
+	raw, percentage, err := tc.ReadRawMoisture(1, "A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw != 1400 || percentage != 50 {
+		t.Errorf("read %d with %v%%, want 1400 with 50%%", raw, percentage)
+	}
+
+	var running sensors.Calibration
+	tc.inLoop(1, func(s *station) {
+		running = s.settings.Ports[0].Calibration
+	})
+	if running.Dry != 1800 {
+		t.Errorf("station runs with calibration %+v, want dry at 1800", running)
+	}
+
+	saved, err := sensors.LoadSettings(tc.settingsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Stations[0].Ports[0].Calibration.Dry != 1800 {
+		t.Errorf("settings file has calibration %+v, want dry at 1800", saved.Stations[0].Ports[0].Calibration)
+	}
+	if before.Stations[0].Ports[0].Calibration.Dry != sensors.DefaultCalibration.Dry {
+		t.Error("calibration changed settings which were handed out before")
+	}
+}
+
+func TestCalibrationOfAnUnknownPortIsNotFound(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+
+	if _, _, err := tc.ReadRawMoisture(1, "B"); Code(err) != ErrorCodeNotFound {
+		t.Errorf("reading port B returned %v, want not found", err)
+	}
+	if _, err := tc.CaptureCalibration(2, "A", model.CalibrationTargetWet); Code(err) != ErrorCodeNotFound {
+		t.Errorf("calibrating station 2 returned %v, want not found", err)
+	}
+}
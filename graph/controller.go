@@ -2,23 +2,19 @@ package graph
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/actuators"
+	"github.com/ZamarianPatrick/lazypig-backend/broker"
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
 	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/mqtt"
+	"github.com/ZamarianPatrick/lazypig-backend/notify"
 	"github.com/ZamarianPatrick/lazypig-backend/sensors"
-	"github.com/google/uuid"
-	"gopkg.in/yaml.v2"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
-	"io/ioutil"
 	"log"
-	"math"
-	"math/rand"
 	"os"
-	"periph.io/x/conn/v3/gpio"
-	"periph.io/x/conn/v3/i2c"
-	"periph.io/x/conn/v3/i2c/i2creg"
 	"periph.io/x/host/v3"
 	"sync"
 	"time"
@@ -26,65 +22,117 @@ import (
 
 type Controller interface {
 	DB() *gorm.DB
-	PossibleStationPorts() []string
+	FakeValues() bool
+	Board(stationID uint64) (actuators.Board, error)
+	PossibleStationPorts(stationID uint64) ([]string, error)
 	StationChannel(ctx context.Context) chan *model.Station
-	SetMoistureFakeValue(port string, value float64)
-	SetWaterLevelFakeValue(value float64)
+	WateringEventChannel(ctx context.Context, stationID *uint64) chan *model.WateringEvent
+	RawReadings(stationID uint64) ([]*model.RawReading, error)
+	RawReadingChannel(ctx context.Context, stationID uint64) (chan *model.RawReading, error)
+	PlantStatus(stationID uint64) ([]*model.PlantStatus, error)
+	PlantStatusChannel(ctx context.Context, stationID uint64) (chan *model.PlantStatus, error)
+	SensorHealth(stationID *uint64) ([]*model.SensorHealth, error)
+	AcknowledgeAlert(id uint64, by string) (*model.Alert, error)
+	SubscriptionMetrics() []broker.Metrics
+	SetMoistureFakeValue(stationID uint64, port string, value float64) error
+	SetWaterLevelFakeValue(stationID uint64, value float64) error
+	Calibration(stationID uint64, port string) (sensors.Calibration, error)
+	CaptureCalibration(stationID uint64, port string, target model.CalibrationTarget) (sensors.Calibration, error)
+	SetCalibrationCurve(stationID uint64, port string, points []sensors.CalibrationPoint) (sensors.Calibration, error)
+	ReadRawMoisture(stationID uint64, port string) (uint16, float64, error)
+	StationSettings() sensors.Settings
+	UpdateStationSettings(settings sensors.Settings) (sensors.Settings, error)
+	WaterPlant(ctx context.Context, plantID uint64, duration time.Duration, target *float64) (*model.WateringResult, error)
+	PlantChanged(plant *model.Plant)
+	PlantDeleted(plantID uint64)
 }
 
 type controller struct {
 	db              *gorm.DB
-	bus             i2c.BusCloser
-	sensorWorker    sensors.Worker
-	mutex           sync.RWMutex
-	stationChannels map[string]chan *model.Station
-	stationSettings *sensors.StationSettings
-
-	moistureFakes  []*sensors.MoistureFake
-	waterLevelFake *sensors.WaterFake
+	board           actuators.Board
+	fakeBoards      map[uint64]actuators.Board
+	stations        map[uint64]*station
+	stationsMutex   sync.RWMutex
+	broker          *broker.Broker
+	settings        *sensors.Settings
+	settingsPath    string
+	settingsModTime time.Time
+	settingsMutex   sync.Mutex
+
+	notifiers        []notify.Notifier
+	notifications    chan notify.Notification
+	renotifyInterval time.Duration
+	alertKinds       map[model.AlarmKind]bool
 
 	fakeValues bool
-	random     *rand.Rand
+	clock      clock.Clock
+	seed       int64
 }
 
-func NewController(fakeValues bool) (Controller, error) {
+// Options configure how the controller runs, paths left empty are taken from the working directory.
+type Options struct {
+	FakeValues   bool
+	SettingsPath string
+	DBPath       string
+	// LogLevel is the log level of the database, 0 logs every statement
+	LogLevel logger.LogLevel
+	// Clock drives the control loops, the sensor workers and the fakes, nil uses the system clock
+	Clock clock.Clock
+	// Seed seeds the random numbers of the fakes, 0 seeds with the current time
+	Seed int64
+	// SessionLifetime is how long a login is valid, 0 uses DefaultSessionLifetime
+	SessionLifetime time.Duration
+	// Notifiers are told about alerts, without notifiers alerts are only stored
+	Notifiers []notify.Notifier
+	// RenotifyInterval is how often alerts which are neither acknowledged nor resolved are sent again, 0 sends them once
+	RenotifyInterval time.Duration
+	// AlertKinds are the alarm kinds which become alerts, nil makes every kind an alert
+	AlertKinds []model.AlarmKind
+	// MQTT publishes the readings, actuators and stations to a MQTT broker and takes commands from it, without a broker MQTT is off
+	MQTT mqtt.Config
+}
 
-	basePath := "./"
-	settingsFileName := "stationSettings.yml"
+// topics of the broker, subscribers of a single station filter by station
+const (
+	topicStations       = "stations"
+	topicWateringEvents = "wateringEvents"
+	topicRawReadings    = "rawReadings"
+	topicPlantStatus    = "plantStatus"
+	topicPlants         = "plants"
+)
 
-	var stationSettings sensors.StationSettings
+// plantChange is published when a plant was created, changed or deleted, Plant is nil once the plant is deleted.
+type plantChange struct {
+	PlantID uint64
+	Plant   *model.Plant
+}
 
-	if _, err := os.Stat(basePath + settingsFileName); errors.Is(err, os.ErrNotExist) {
-		stationSettings = sensors.DefaultStationSettings
-		f, err := os.Create(basePath + settingsFileName)
-		if err != nil {
-			return nil, err
-		}
+func NewController(options Options) (Controller, error) {
+	fakeValues := options.FakeValues
 
-		data, err := yaml.Marshal(stationSettings)
-		if err != nil {
-			return nil, err
-		}
-
-		_, err = f.Write(data)
-		if err != nil {
-			return nil, err
-		}
+	if options.Clock == nil {
+		options.Clock = clock.Real()
+	}
+	if options.Seed == 0 {
+		options.Seed = time.Now().UnixNano()
+	}
+	if options.SettingsPath == "" {
+		options.SettingsPath = "./stationSettings.yml"
+	}
+	if options.DBPath == "" {
+		options.DBPath = "./db.sqlite"
+	}
+	if options.LogLevel == 0 {
+		options.LogLevel = logger.Info
+	}
 
-		f.Close()
-	} else {
-		data, err := ioutil.ReadFile(basePath + settingsFileName)
-		if err != nil {
-			return nil, err
-		}
-		err = yaml.Unmarshal(data, &stationSettings)
-		if err != nil {
-			return nil, err
-		}
+	settings, err := sensors.LoadSettings(options.SettingsPath)
+	if err != nil {
+		return nil, err
 	}
 
-	db, err := gorm.Open(sqlite.Open(basePath+"db.sqlite"), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+	db, err := gorm.Open(sqlite.Open(options.DBPath), &gorm.Config{
+		Logger: logger.Default.LogMode(options.LogLevel),
 	})
 	if err != nil {
 		return nil, err
@@ -96,254 +144,300 @@ func NewController(fakeValues bool) (Controller, error) {
 	}
 
 	db.AutoMigrate(&model.PlantTemplate{})
+	db.Model(&model.PlantTemplate{}).Where("stop_threshold < water_threshold").Update("stop_threshold", gorm.Expr("water_threshold"))
 	db.AutoMigrate(&model.Station{})
-	db.AutoMigrate(&model.Plant{})
-
-	var station model.Station
-	r = db.First(&station, 1)
-	if r.Error != nil {
-		station = model.Station{
-			Name: "Station 1",
-		}
-		db.Create(&station)
+	if db.Migrator().HasTable(&model.Plant{}) {
+		// only one active plant per port is allowed, of older duplicates the first one stays active
+		db.Model(&model.Plant{}).
+			Where("active = ? AND id NOT IN (?)", true, db.Model(&model.Plant{}).Select("MIN(id)").Where("active = ?", true).Group("station_id, port")).
+			Update("active", false)
 	}
-
-	randomSource := rand.NewSource(time.Now().UnixNano())
-	random := rand.New(randomSource)
+	db.AutoMigrate(&model.Plant{})
+	db.AutoMigrate(&model.Reading{})
+	db.AutoMigrate(&model.WateringEvent{})
+	db.AutoMigrate(&model.Alarm{})
+	db.AutoMigrate(&model.Alert{})
+	// acknowledged alerts about events used to stay open and swallow every later event of their kind
+	db.Model(&model.Alert{}).
+		Where("acknowledged_at IS NOT NULL AND resolved_at IS NULL AND kind NOT IN ?", conditionKinds).
+		Update("resolved_at", gorm.Expr("acknowledged_at"))
+	db.AutoMigrate(&model.WateringSchedule{})
+	db.AutoMigrate(&model.User{})
+	// users and keys from before the roles could do everything
+	db.Model(&model.User{}).Where("role = ? OR role IS NULL", "").Update("role", model.RoleAdmin)
+	db.AutoMigrate(&model.Session{})
+	db.AutoMigrate(&model.APIKey{})
+	db.Model(&model.APIKey{}).Where("role = ? OR role IS NULL", "").Update("role", model.RoleAdmin)
 
 	c := controller{
-		db:              db,
-		stationChannels: make(map[string]chan *model.Station),
-		stationSettings: &stationSettings,
-		moistureFakes:   make([]*sensors.MoistureFake, 0),
-		random:          random,
+		db:           db,
+		fakeBoards:   make(map[uint64]actuators.Board),
+		stations:     make(map[uint64]*station),
+		broker:       broker.New(),
+		settings:     settings,
+		settingsPath: options.SettingsPath,
+		fakeValues:   fakeValues,
+		clock:        options.Clock,
+		seed:         options.Seed,
+
+		notifiers:        options.Notifiers,
+		notifications:    make(chan notify.Notification, notificationQueueSize),
+		renotifyInterval: options.RenotifyInterval,
+		alertKinds:       make(map[model.AlarmKind]bool),
 	}
 
-	fake := sensors.NewWaterFake(100)
-	waterFake := fake.(*sensors.WaterFake)
-
-	var sensorWorker sensors.Worker
-	if fakeValues {
-		sensorWorker =
-			sensors.NewWorker().
-				Add(waterFake)
+	if options.AlertKinds == nil {
+		options.AlertKinds = model.AllAlarmKind
+	}
+	for _, kind := range options.AlertKinds {
+		c.alertKinds[kind] = true
+	}
 
-	} else {
+	if !fakeValues {
 		_, err = host.Init()
 		if err != nil {
 			return nil, err
 		}
 
-		bus, err := i2creg.Open("1")
-		if err != nil {
-			return nil, err
-		}
-
-		c.bus = bus
-
-		sensorWorker =
-			sensors.NewWorker().
-				Add(sensors.NewWaterLevel(bus, stationSettings.WaterLevelHighAddress, stationSettings.WaterLevelLowAddress))
-
+		c.board = actuators.NewRPiBoard()
 	}
 
-	c.waterLevelFake = waterFake
-	c.sensorWorker = sensorWorker
-
-	for _, port := range stationSettings.Ports {
-		var s sensors.Sensor
-		if fakeValues {
-			s = sensors.NewMoistureFake(port)
-			moistureFake := s.(*sensors.MoistureFake)
-			c.moistureFakes = append(c.moistureFakes, moistureFake)
-		} else {
-			s = sensors.NewMoisture(c.bus, stationSettings.MoistureAddress, port)
-		}
-
-		sensorWorker.Add(s)
+	if err = c.validateSettings(settings); err != nil {
+		return nil, err
 	}
 
-	pin, err := sensors.GetGPIO(c.stationSettings.PumpGPIO)
+	c.stations, err = c.buildStations(settings)
 	if err != nil {
 		return nil, err
 	}
-	pin.Out(gpio.Low)
 
-	for _, p := range stationSettings.Ports {
-		pin, err := sensors.GetGPIO(p.ValveGPIO)
-		if err != nil {
+	go c.deliverNotifications()
+	go c.watchAlerts()
+
+	if options.MQTT.Enabled() {
+		if err = c.startMQTT(options.MQTT); err != nil {
 			return nil, err
 		}
-		pin.Out(gpio.Low)
 	}
 
-	c.ReadSensors()
-	sensorWorker.Start()
+	for _, s := range c.stations {
+		s.Start()
+	}
+
+	if info, err := os.Stat(c.settingsPath); err == nil {
+		c.settingsModTime = info.ModTime()
+	}
+	go c.watchSettings()
+
 	return &c, nil
 }
 
-func (c *controller) SetMoistureFakeValue(port string, value float64) {
-	for _, m := range c.moistureFakes {
-		if m.Port().Port == port {
-			m.SetValue(value)
-		}
+func (c *controller) SetMoistureFakeValue(stationID uint64, port string, value float64) error {
+	s, err := c.station(stationID)
+	if err != nil {
+		return err
 	}
+
+	return s.SetMoistureFakeValue(port, value)
 }
 
-func (c *controller) SetWaterLevelFakeValue(value float64) {
-	actualVal, _ := c.waterLevelFake.ReadValue()
-	for actualVal != value {
-		if actualVal > value {
-			actualVal -= 1
-		} else {
-			actualVal += 1
-		}
-		c.waterLevelFake.SetValue(actualVal)
+func (c *controller) SetWaterLevelFakeValue(stationID uint64, value float64) error {
+	s, err := c.station(stationID)
+	if err != nil {
+		return err
+	}
+
+	return s.SetWaterLevelFakeValue(value)
+}
 
-		timeout := time.Duration(c.random.Intn(200) + 100)
-		time.Sleep(time.Millisecond * timeout)
+func (c *controller) startWateringEvent(plant *model.Plant, reason model.WateringReason, moisture float64) *model.WateringEvent {
+	event := &model.WateringEvent{
+		PlantID:        plant.ID,
+		StationID:      plant.StationID,
+		Port:           plant.Port,
+		Reason:         reason,
+		StartedAt:      c.clock.Now().UTC(),
+		MoistureBefore: moisture,
 	}
+
+	if res := c.db.Create(event); res.Error != nil {
+		log.Println("could not store watering event", res.Error)
+	}
+
+	c.publishWateringEvent(event)
+	return event
 }
 
-type plantState struct {
-	moistureValue float64
-	pumpRequired  bool
+func (c *controller) finishWateringEvent(event *model.WateringEvent, moisture float64, pulses int) {
+	endedAt := c.clock.Now().UTC()
+	event.EndedAt = &endedAt
+	event.MoistureAfter = &moisture
+	event.Pulses = pulses
+
+	if res := c.db.Save(event); res.Error != nil {
+		log.Println("could not store watering event", res.Error)
+	}
+
+	c.publishWateringEvent(event)
 }
 
-func (c *controller) ReadSensors() {
-	go func() {
-		var ch chan sensors.SensorData
-		ch = c.sensorWorker.DataChannel()
-
-		stationID := 1
-		lastWaterLevel := -1.0
-		lastPlantStates := make(map[string]*plantState)
-
-		for true {
-			data := <-ch
-
-			switch data.SensorName {
-			case "Water Level":
-				if lastWaterLevel < 0 || math.Abs(lastWaterLevel-data.Value) > 1 {
-					var station model.Station
-					c.db.First(&station, stationID)
-					station.WaterLevel = data.Value
-					c.db.Save(&station)
-
-					c.mutex.RLock()
-					for _, out := range c.stationChannels {
-						out <- &station
-					}
-					c.mutex.RUnlock()
-
-					lastWaterLevel = data.Value
-				}
-
-				break
-
-			case "Moisture":
-				var lastPlantState *plantState
-				var ok bool
-				if lastPlantState, ok = lastPlantStates[data.Port.Port]; !ok {
-					lastPlantState = &plantState{}
-					lastPlantStates[data.Port.Port] = lastPlantState
-				}
-
-				if !ok || math.Abs(lastPlantState.moistureValue-data.Value) > 3 {
-					var plant model.Plant
-					c.db.Preload("Template").Where("port = ? AND station_id = ?", data.Port.Port, stationID).First(&plant)
-
-					if plant.Active {
-						if plant.Template.WaterThreshold >= data.Value {
-							fmt.Println("Port", data.Port.Port, data.Value)
-							if lastWaterLevel > 1 {
-								lastPlantState.pumpRequired = true
-								pin, err := sensors.GetGPIO(data.Port.ValveGPIO)
-								if err != nil {
-									fmt.Println(err)
-									continue
-								}
-								pin.Out(gpio.Low)
-							} else {
-								log.Println("Port", data.Port.Port, "plant is thirsty but no water is there :(")
-							}
-						} else {
-							log.Println("Port", data.Port.Port, "plant not thirsty", data.Value)
-							lastPlantState.pumpRequired = false
-							pin, err := sensors.GetGPIO(data.Port.ValveGPIO)
-							if err != nil {
-								fmt.Println(err)
-								continue
-							}
-							pin.Out(gpio.High)
-						}
-					} else {
-						log.Println("Port", data.Port.Port, "plant not active")
-					}
-					lastPlantState.moistureValue = data.Value
-				}
-
-				break
-			}
-
-			pumpOn := false
-			for _, v := range lastPlantStates {
-				if v.pumpRequired {
-					pumpOn = true
-					break
-				}
-			}
-
-			if pumpOn {
-				pin, err := sensors.GetGPIO(c.stationSettings.PumpGPIO)
-				if err != nil {
-					fmt.Println(err)
-					continue
-				}
-				pin.Out(gpio.Low)
-			} else {
-				pin, err := sensors.GetGPIO(c.stationSettings.PumpGPIO)
-				if err != nil {
-					fmt.Println(err)
-					continue
-				}
-				pin.Out(gpio.High)
-			}
-		}
-	}()
+// publishWateringEvent hands the event to the subscribers, they get the start and the end of every watering.
+// Subscribers get a copy, the station goes on changing the event while they still send its start.
+func (c *controller) publishWateringEvent(event *model.WateringEvent) {
+	e := *event
+	c.broker.Publish(topicWateringEvents, fmt.Sprint(event.ID), &e)
+}
+
+func (c *controller) publishRawReading(reading *model.RawReading) {
+	c.broker.Publish(topicRawReadings, reading.SensorName+"/"+reading.Port, reading)
+}
+
+func (c *controller) raiseAlarm(alarm *model.Alarm) {
+	log.Println("Station", alarm.StationID, "alarm", alarm.Kind, alarm.Message)
+
+	alarm.CreatedAt = c.clock.Now().UTC()
+	if res := c.db.Create(alarm); res.Error != nil {
+		log.Println("could not store alarm", res.Error)
+	}
+
+	c.raiseAlert(alarm)
 }
 
 func (c *controller) DB() *gorm.DB {
 	return c.db
 }
 
+// FakeValues reports whether the stations run with fake sensors and actuators instead of real hardware.
+func (c *controller) FakeValues() bool {
+	return c.fakeValues
+}
+
+func (c *controller) Board(stationID uint64) (actuators.Board, error) {
+	s, err := c.station(stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.board, nil
+}
+
+func (c *controller) station(stationID uint64) (*station, error) {
+	c.stationsMutex.RLock()
+	defer c.stationsMutex.RUnlock()
+
+	s, ok := c.stations[stationID]
+	if !ok {
+		return nil, notFound("station %d not found", stationID)
+	}
+
+	return s, nil
+}
+
+func (c *controller) publishStation(station *model.Station) {
+	c.broker.Publish(topicStations, fmt.Sprint(station.ID), station)
+}
+
+// PlantChanged tells the subscribers about a plant which was created or changed, they get a copy of it.
+func (c *controller) PlantChanged(plant *model.Plant) {
+	p := *plant
+	c.broker.Publish(topicPlants, fmt.Sprint(plant.ID), &plantChange{PlantID: plant.ID, Plant: &p})
+}
+
+func (c *controller) PlantDeleted(plantID uint64) {
+	c.broker.Publish(topicPlants, fmt.Sprint(plantID), &plantChange{PlantID: plantID})
+}
+
 func (c *controller) StationChannel(ctx context.Context) chan *model.Station {
 	ch := make(chan *model.Station)
-	uuid, _ := uuid.NewUUID()
+	sub := c.broker.Subscribe(topicStations, broker.Options{Policy: broker.Coalesce})
+
+	go forward(ctx, sub, func(payload interface{}) bool {
+		select {
+		case ch <- payload.(*model.Station):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(ch) })
 
-	c.mutex.Lock()
-	c.stationChannels[uuid.String()] = ch
-	c.mutex.Unlock()
+	return ch
+}
 
-	go func() {
-		<-ctx.Done()
-		c.mutex.Lock()
-		delete(c.stationChannels, uuid.String())
-		c.mutex.Unlock()
+func (c *controller) WateringEventChannel(ctx context.Context, stationID *uint64) chan *model.WateringEvent {
+	ch := make(chan *model.WateringEvent)
+	// the start and the end of a watering are not merged, a subscriber which falls behind misses the oldest events
+	sub := c.broker.Subscribe(topicWateringEvents, broker.Options{
+		Policy: broker.DropOldest,
+		Filter: func(payload interface{}) bool {
+			return stationID == nil || payload.(*model.WateringEvent).StationID == *stationID
+		},
+	})
 
-		log.Println("ws client closed", uuid.String())
-	}()
+	go forward(ctx, sub, func(payload interface{}) bool {
+		select {
+		case ch <- payload.(*model.WateringEvent):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(ch) })
 
 	return ch
 }
 
-func (c *controller) PossibleStationPorts() []string {
+func (c *controller) RawReadings(stationID uint64) ([]*model.RawReading, error) {
+	s, err := c.station(stationID)
+	if err != nil {
+		return nil, err
+	}
 
-	portNames := make([]string, len(c.stationSettings.Ports))
+	return s.RawReadings(), nil
+}
 
-	for i, p := range c.stationSettings.Ports {
-		portNames[i] = p.Port
+func (c *controller) RawReadingChannel(ctx context.Context, stationID uint64) (chan *model.RawReading, error) {
+	if _, err := c.station(stationID); err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *model.RawReading)
+	sub := c.broker.Subscribe(topicRawReadings, broker.Options{
+		Policy: broker.Coalesce,
+		Filter: func(payload interface{}) bool {
+			return payload.(*model.RawReading).StationID == stationID
+		},
+	})
+
+	go forward(ctx, sub, func(payload interface{}) bool {
+		select {
+		case ch <- payload.(*model.RawReading):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(ch) })
+
+	return ch, nil
+}
+
+// SubscriptionMetrics returns how many messages the subscribers of each topic got, missed or got merged.
+func (c *controller) SubscriptionMetrics() []broker.Metrics {
+	return c.broker.Metrics()
+}
+
+// forward runs the subscription of a websocket client until the client is gone. deliver blocks as long as the client
+// does not take the message, which only holds up this goroutine, the publishing loops only see the queue of the subscription.
+func forward(ctx context.Context, sub *broker.Subscription, deliver func(payload interface{}) bool, done func()) {
+	sub.Run(ctx, deliver)
+	done()
+
+	log.Println("ws client closed, dropped messages:", sub.Dropped())
+}
+
+func (c *controller) PossibleStationPorts(stationID uint64) ([]string, error) {
+	s, err := c.station(stationID)
+	if err != nil {
+		return nil, err
 	}
 
-	return portNames
+	return s.Ports(), nil
 }
@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"gorm.io/gorm"
+)
+
+// ErrorCode is sent as extensions.code of a GraphQL error, so clients can handle errors without parsing messages.
+type ErrorCode string
+
+const (
+	// ErrorCodeNotFound is used if a plant, template, schedule, station or port does not exist.
+	ErrorCodeNotFound ErrorCode = "NOT_FOUND"
+	// ErrorCodeValidation is used if the arguments of a query or mutation are invalid.
+	ErrorCodeValidation ErrorCode = "VALIDATION"
+	// ErrorCodeConflict is used if the request is valid but clashes with the current state, like watering a plant twice.
+	ErrorCodeConflict ErrorCode = "CONFLICT"
+	// ErrorCodeHardware is used if a sensor, relay or bus failed.
+	ErrorCodeHardware ErrorCode = "HARDWARE"
+	// ErrorCodeUnauthenticated is used if a request has no valid session token or API key.
+	ErrorCodeUnauthenticated ErrorCode = "UNAUTHENTICATED"
+	// ErrorCodeForbidden is used if the role of the request is too low, or the field is not available with the hardware in use.
+	ErrorCodeForbidden ErrorCode = "FORBIDDEN"
+)
+
+// Error is an error with a code for the client. Errors without code are reported without extensions.
+// Fields names the input fields which caused the error, if the error is about the arguments.
+type Error struct {
+	Code   ErrorCode
+	Fields []FieldError
+	err    error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func newError(code ErrorCode, format string, args ...interface{}) error {
+	return &Error{
+		Code: code,
+		err:  fmt.Errorf(format, args...),
+	}
+}
+
+func notFound(format string, args ...interface{}) error {
+	return newError(ErrorCodeNotFound, format, args...)
+}
+
+func validationError(format string, args ...interface{}) error {
+	return newError(ErrorCodeValidation, format, args...)
+}
+
+func conflict(format string, args ...interface{}) error {
+	return newError(ErrorCodeConflict, format, args...)
+}
+
+func hardwareError(format string, args ...interface{}) error {
+	return newError(ErrorCodeHardware, format, args...)
+}
+
+func unauthenticated(format string, args ...interface{}) error {
+	return newError(ErrorCodeUnauthenticated, format, args...)
+}
+
+func forbidden(format string, args ...interface{}) error {
+	return newError(ErrorCodeForbidden, format, args...)
+}
+
+// withCode gives err the code, unless it already carries one.
+func withCode(code ErrorCode, err error) error {
+	if err == nil || Code(err) != "" {
+		return err
+	}
+
+	return &Error{
+		Code: code,
+		err:  err,
+	}
+}
+
+// Code returns the code of err, or an empty code if it has none.
+func Code(err error) ErrorCode {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return ""
+}
+
+// findByID loads the record with the id into dest and reports a missing record as not found error.
+func findByID(query *gorm.DB, dest interface{}, kind string, id uint64) error {
+	res := query.First(dest, id)
+	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+		return notFound("%s %d not found", kind, id)
+	}
+	return res.Error
+}
+
+// ErrorPresenter adds the code and the field errors of typed errors to the extensions of the GraphQL error.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	var e *Error
+	if !errors.As(err, &e) {
+		return gqlErr
+	}
+
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = make(map[string]interface{})
+	}
+	gqlErr.Extensions["code"] = string(e.Code)
+	if len(e.Fields) > 0 {
+		gqlErr.Extensions["fields"] = e.Fields
+	}
+
+	return gqlErr
+}
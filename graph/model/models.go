@@ -1,19 +1,36 @@
 package model
 
+import (
+	"time"
+)
+
 type Plant struct {
-	ID         uint64        `json:"id" gorm:"primaryKey"`
-	StationID  uint64        `json:"stationID"`
-	Active     bool          `json:"active"`
-	Name       string        `json:"name"`
-	Port       string        `json:"port"`
-	TemplateID uint64        `json:"-"`
-	Template   PlantTemplate `json:"template" gorm:"foreignKey:TemplateID;references:ID"`
+	ID              uint64        `json:"id" gorm:"primaryKey"`
+	StationID       uint64        `json:"stationID" gorm:"uniqueIndex:idx_plants_active_port,where:active"`
+	Active          bool          `json:"active"`
+	Name            string        `json:"name"`
+	Port            string        `json:"port" gorm:"uniqueIndex:idx_plants_active_port,where:active"`
+	TemplateID      uint64        `json:"-"`
+	Template        PlantTemplate `json:"template" gorm:"foreignKey:TemplateID;references:ID"`
+	EvaporationRate float64       `json:"evaporationRate"`
 }
 
 type PlantTemplate struct {
 	ID             uint64  `json:"id" gorm:"primaryKey"`
 	Name           string  `json:"name"`
 	WaterThreshold float64 `json:"waterThreshold"`
+	StopThreshold  float64 `json:"stopThreshold"`
+	PulseSeconds   int     `json:"pulseSeconds"`
+	SoakSeconds    int     `json:"soakSeconds"`
+	MaxPulses      int     `json:"maxPulses"`
+}
+
+// WateringStopThreshold returns the moisture a watering stops at, templates without a higher stop threshold stop at the water threshold.
+func (t *PlantTemplate) WateringStopThreshold() float64 {
+	if t.StopThreshold > t.WaterThreshold {
+		return t.StopThreshold
+	}
+	return t.WaterThreshold
 }
 
 type Station struct {
@@ -22,3 +39,272 @@ type Station struct {
 	WaterLevel float64 `json:"waterLevel"`
 	Plants     []Plant `json:"plants"`
 }
+
+type Reading struct {
+	ID         uint64 `json:"id" gorm:"primaryKey"`
+	StationID  uint64 `json:"stationID" gorm:"index:idx_readings_series"`
+	SensorName string `json:"sensorName" gorm:"index:idx_readings_series"`
+	Port       string `json:"port" gorm:"index:idx_readings_series"`
+	// PlantID is the plant which was active on the moisture sensor's port, 0 if none was.
+	// Readings stored before the plant was stored with them have none.
+	PlantID   *uint64   `json:"plantID" gorm:"index"`
+	Value     float64   `json:"value"`
+	Timestamp time.Time `json:"timestamp" gorm:"index:idx_readings_series"`
+}
+
+type ReadingBucket struct {
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	Min     float64   `json:"min"`
+	Max     float64   `json:"max"`
+	Average float64   `json:"average"`
+	Count   int       `json:"count"`
+}
+
+type WateringEvent struct {
+	ID             uint64         `json:"id" gorm:"primaryKey"`
+	PlantID        uint64         `json:"plantID" gorm:"index"`
+	StationID      uint64         `json:"stationID" gorm:"index"`
+	Port           string         `json:"port"`
+	Reason         WateringReason `json:"reason"`
+	StartedAt      time.Time      `json:"startedAt" gorm:"index"`
+	EndedAt        *time.Time     `json:"endedAt"`
+	MoistureBefore float64        `json:"moistureBefore"`
+	MoistureAfter  *float64       `json:"moistureAfter"`
+	Pulses         int            `json:"pulses"`
+}
+
+// Duration returns the watering time in seconds, nil as long as the event is not finished.
+func (e *WateringEvent) Duration() *float64 {
+	if e.EndedAt == nil {
+		return nil
+	}
+
+	d := e.EndedAt.Sub(e.StartedAt).Seconds()
+	return &d
+}
+
+type WateringEventPage struct {
+	Events     []*WateringEvent `json:"events"`
+	TotalCount int              `json:"totalCount"`
+	HasMore    bool             `json:"hasMore"`
+}
+
+type Alarm struct {
+	ID        uint64    `json:"id" gorm:"primaryKey"`
+	StationID uint64    `json:"stationID" gorm:"index"`
+	PlantID   *uint64   `json:"plantID"`
+	Port      string    `json:"port"`
+	Kind      AlarmKind `json:"kind"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"createdAt" gorm:"index"`
+}
+
+type AlarmPage struct {
+	Alarms     []*Alarm `json:"alarms"`
+	TotalCount int      `json:"totalCount"`
+	HasMore    bool     `json:"hasMore"`
+}
+
+// Alert is an alarm people are notified about. Alarms of the same kind for the same port of a station are
+// a single alert as long as it is not resolved, they only raise its count.
+type Alert struct {
+	ID             uint64     `json:"id" gorm:"primaryKey"`
+	DedupKey       string     `json:"-" gorm:"index"`
+	StationID      uint64     `json:"stationID" gorm:"index"`
+	PlantID        *uint64    `json:"plantID"`
+	Port           string     `json:"port"`
+	Kind           AlarmKind  `json:"kind"`
+	Message        string     `json:"message"`
+	Count          int        `json:"count"`
+	FirstRaisedAt  time.Time  `json:"firstRaisedAt" gorm:"index"`
+	LastRaisedAt   time.Time  `json:"lastRaisedAt"`
+	LastNotifiedAt *time.Time `json:"lastNotifiedAt"`
+	AcknowledgedAt *time.Time `json:"acknowledgedAt"`
+	AcknowledgedBy *string    `json:"acknowledgedBy"`
+	ResolvedAt     *time.Time `json:"resolvedAt"`
+}
+
+// Active reports whether the alert is neither acknowledged nor resolved, only active alerts are sent again.
+func (a *Alert) Active() bool {
+	return a.AcknowledgedAt == nil && a.ResolvedAt == nil
+}
+
+type AlertPage struct {
+	Alerts     []*Alert `json:"alerts"`
+	TotalCount int      `json:"totalCount"`
+	HasMore    bool     `json:"hasMore"`
+}
+
+type CalibrationCurvePoint struct {
+	Raw        int     `json:"raw"`
+	Percentage float64 `json:"percentage"`
+}
+
+type PortCalibration struct {
+	StationID uint64                   `json:"stationID"`
+	Port      string                   `json:"port"`
+	Dry       int                      `json:"dry"`
+	Wet       int                      `json:"wet"`
+	Points    []*CalibrationCurvePoint `json:"points"`
+}
+
+type CalibrationPreview struct {
+	StationID  uint64  `json:"stationID"`
+	Port       string  `json:"port"`
+	Raw        int     `json:"raw"`
+	Percentage float64 `json:"percentage"`
+}
+
+type RawValue struct {
+	Name   string `json:"name"`
+	Values []int  `json:"values"`
+}
+
+type RawReading struct {
+	StationID  uint64      `json:"stationID"`
+	SensorName string      `json:"sensorName"`
+	Port       string      `json:"port"`
+	Value      float64     `json:"value"`
+	Raw        []*RawValue `json:"raw"`
+	Timestamp  time.Time   `json:"timestamp"`
+}
+
+type WateringResult struct {
+	Event         *WateringEvent     `json:"event"`
+	StopReason    WateringStopReason `json:"stopReason"`
+	ReachedTarget bool               `json:"reachedTarget"`
+	Moisture      float64            `json:"moisture"`
+}
+
+// WateringSchedule limits when or makes sure that the plants of a station, or a single plant, get water.
+// Start and end are local times formatted as 15:04, a window whose end is before its start spans midnight.
+type WateringSchedule struct {
+	ID        uint64       `json:"id" gorm:"primaryKey"`
+	StationID uint64       `json:"stationID" gorm:"index"`
+	PlantID   *uint64      `json:"plantID" gorm:"index"`
+	Kind      ScheduleKind `json:"kind"`
+	Weekdays  []Weekday    `json:"weekdays" gorm:"serializer:json"`
+	Start     string       `json:"start"`
+	End       string       `json:"end"`
+	Seconds   int          `json:"seconds"`
+	Enabled   bool         `json:"enabled"`
+}
+
+type StationSettings struct {
+	StationID             uint64              `json:"stationID"`
+	Name                  string              `json:"name"`
+	GroveBus              string              `json:"groveBus"`
+	WaterLevelHighAddress int                 `json:"waterLevelHighAddress"`
+	WaterLevelLowAddress  int                 `json:"waterLevelLowAddress"`
+	MoistureAddress       int                 `json:"moistureAddress"`
+	PumpGPIO              int                 `json:"pumpGPIO"`
+	MaxDailyPumpSeconds   int                 `json:"maxDailyPumpSeconds"`
+	MinWaterLevel         float64             `json:"minWaterLevel"`
+	ReservoirAlertLevel   float64             `json:"reservoirAlertLevel"`
+	MaxSensorFailures     int                 `json:"maxSensorFailures"`
+	StuckSensorMinutes    int                 `json:"stuckSensorMinutes"`
+	Ports                 []*PortSettings     `json:"ports"`
+	Simulation            *SimulationSettings `json:"simulation"`
+	RecordFile            *string             `json:"recordFile"`
+	ReplayFile            *string             `json:"replayFile"`
+}
+
+type PortSettings struct {
+	Port            string           `json:"port"`
+	MoistureChannel int              `json:"moistureChannel"`
+	ValveGPIO       int              `json:"valveGPIO"`
+	MaxOpenSeconds  int              `json:"maxOpenSeconds"`
+	CooldownSeconds int              `json:"cooldownSeconds"`
+	Calibration     *PortCalibration `json:"calibration"`
+}
+
+type SimulationSettings struct {
+	Enabled         bool    `json:"enabled"`
+	EvaporationRate float64 `json:"evaporationRate"`
+	ValveFlowRate   float64 `json:"valveFlowRate"`
+	PumpDrainRate   float64 `json:"pumpDrainRate"`
+}
+
+type User struct {
+	ID           uint64    `json:"id" gorm:"primaryKey"`
+	Username     string    `json:"username" gorm:"uniqueIndex"`
+	PasswordHash string    `json:"-"`
+	Role         Role      `json:"role"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// Session is a login of a user, it is looked up by the hash of its token.
+type Session struct {
+	ID        uint64    `json:"id" gorm:"primaryKey"`
+	UserID    uint64    `json:"userID" gorm:"index"`
+	TokenHash string    `json:"-" gorm:"uniqueIndex"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// APIKey is a long-lived token of a user for scripts, Prefix is the start of the key to tell keys apart.
+// A key never grants more than the role of its user.
+type APIKey struct {
+	ID         uint64     `json:"id" gorm:"primaryKey"`
+	UserID     uint64     `json:"userID" gorm:"index"`
+	Name       string     `json:"name"`
+	Role       Role       `json:"role"`
+	Prefix     string     `json:"prefix"`
+	KeyHash    string     `json:"-" gorm:"uniqueIndex"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+type AuthPayload struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	User      *User     `json:"user"`
+}
+
+type NewAPIKey struct {
+	Key    string  `json:"key"`
+	APIKey *APIKey `json:"apiKey"`
+}
+
+// PlantStatus is the live state of a port of a station as the control loop sees it.
+type PlantStatus struct {
+	StationID           uint64        `json:"stationID"`
+	Port                string        `json:"port"`
+	PlantID             *uint64       `json:"plantID"`
+	Moisture            *float64      `json:"moisture"`
+	Thirsty             bool          `json:"thirsty"`
+	ValveOpen           bool          `json:"valveOpen"`
+	Phase               WateringPhase `json:"phase"`
+	PumpOn              bool          `json:"pumpOn"`
+	WaterLevel          *float64      `json:"waterLevel"`
+	ThirstyWithoutWater bool          `json:"thirstyWithoutWater"`
+	SensorFault         *SensorFault  `json:"sensorFault"`
+	Status              string        `json:"status"`
+	Timestamp           time.Time     `json:"timestamp"`
+}
+
+// SensorHealth tells whether the values of a sensor can be trusted, Port is empty for the water level sensor.
+type SensorHealth struct {
+	StationID           uint64       `json:"stationID"`
+	SensorName          string       `json:"sensorName"`
+	Port                string       `json:"port"`
+	Healthy             bool         `json:"healthy"`
+	Fault               *SensorFault `json:"fault"`
+	Message             *string      `json:"message"`
+	FaultSince          *time.Time   `json:"faultSince"`
+	LastSuccess         *time.Time   `json:"lastSuccess"`
+	LastFailure         *time.Time   `json:"lastFailure"`
+	LastError           *string      `json:"lastError"`
+	ConsecutiveFailures int          `json:"consecutiveFailures"`
+	UnchangedSince      *time.Time   `json:"unchangedSince"`
+}
+
+type SubscriptionMetrics struct {
+	Topic       string `json:"topic"`
+	Subscribers int    `json:"subscribers"`
+	Published   int    `json:"published"`
+	Delivered   int    `json:"delivered"`
+	Dropped     int    `json:"dropped"`
+	Coalesced   int    `json:"coalesced"`
+}
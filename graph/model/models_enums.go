@@ -0,0 +1,440 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type WateringReason string
+
+const (
+	WateringReasonThreshold WateringReason = "THRESHOLD"
+	WateringReasonManual    WateringReason = "MANUAL"
+	WateringReasonSchedule  WateringReason = "SCHEDULE"
+)
+
+var AllWateringReason = []WateringReason{
+	WateringReasonThreshold,
+	WateringReasonManual,
+	WateringReasonSchedule,
+}
+
+func (e WateringReason) IsValid() bool {
+	switch e {
+	case WateringReasonThreshold, WateringReasonManual, WateringReasonSchedule:
+		return true
+	}
+	return false
+}
+
+func (e WateringReason) String() string {
+	return string(e)
+}
+
+func (e *WateringReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WateringReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WateringReason", str)
+	}
+	return nil
+}
+
+func (e WateringReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AlarmKind string
+
+const (
+	AlarmKindMaxOpenTime         AlarmKind = "MAX_OPEN_TIME"
+	AlarmKindCooldown            AlarmKind = "COOLDOWN"
+	AlarmKindDailyPumpLimit      AlarmKind = "DAILY_PUMP_LIMIT"
+	AlarmKindLowWaterLevel       AlarmKind = "LOW_WATER_LEVEL"
+	AlarmKindSensorFault         AlarmKind = "SENSOR_FAULT"
+	AlarmKindThirstyWithoutWater AlarmKind = "THIRSTY_WITHOUT_WATER"
+	AlarmKindReservoirLow        AlarmKind = "RESERVOIR_LOW"
+)
+
+var AllAlarmKind = []AlarmKind{
+	AlarmKindMaxOpenTime,
+	AlarmKindCooldown,
+	AlarmKindDailyPumpLimit,
+	AlarmKindLowWaterLevel,
+	AlarmKindSensorFault,
+	AlarmKindThirstyWithoutWater,
+	AlarmKindReservoirLow,
+}
+
+func (e AlarmKind) IsValid() bool {
+	switch e {
+	case AlarmKindMaxOpenTime, AlarmKindCooldown, AlarmKindDailyPumpLimit, AlarmKindLowWaterLevel, AlarmKindSensorFault, AlarmKindThirstyWithoutWater, AlarmKindReservoirLow:
+		return true
+	}
+	return false
+}
+
+func (e AlarmKind) String() string {
+	return string(e)
+}
+
+func (e *AlarmKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AlarmKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AlarmKind", str)
+	}
+	return nil
+}
+
+func (e AlarmKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type CalibrationTarget string
+
+const (
+	CalibrationTargetDry CalibrationTarget = "DRY"
+	CalibrationTargetWet CalibrationTarget = "WET"
+)
+
+var AllCalibrationTarget = []CalibrationTarget{
+	CalibrationTargetDry,
+	CalibrationTargetWet,
+}
+
+func (e CalibrationTarget) IsValid() bool {
+	switch e {
+	case CalibrationTargetDry, CalibrationTargetWet:
+		return true
+	}
+	return false
+}
+
+func (e CalibrationTarget) String() string {
+	return string(e)
+}
+
+func (e *CalibrationTarget) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CalibrationTarget(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CalibrationTarget", str)
+	}
+	return nil
+}
+
+func (e CalibrationTarget) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WateringStopReason string
+
+const (
+	WateringStopReasonDurationElapsed WateringStopReason = "DURATION_ELAPSED"
+	WateringStopReasonTargetReached   WateringStopReason = "TARGET_REACHED"
+	WateringStopReasonTimeout         WateringStopReason = "TIMEOUT"
+	WateringStopReasonSafetyLimit     WateringStopReason = "SAFETY_LIMIT"
+	WateringStopReasonLowWaterLevel   WateringStopReason = "LOW_WATER_LEVEL"
+	WateringStopReasonMaxPulses       WateringStopReason = "MAX_PULSES"
+	WateringStopReasonStopped         WateringStopReason = "STOPPED"
+	WateringStopReasonSensorFault     WateringStopReason = "SENSOR_FAULT"
+)
+
+var AllWateringStopReason = []WateringStopReason{
+	WateringStopReasonDurationElapsed,
+	WateringStopReasonTargetReached,
+	WateringStopReasonTimeout,
+	WateringStopReasonSafetyLimit,
+	WateringStopReasonLowWaterLevel,
+	WateringStopReasonMaxPulses,
+	WateringStopReasonStopped,
+	WateringStopReasonSensorFault,
+}
+
+func (e WateringStopReason) IsValid() bool {
+	switch e {
+	case WateringStopReasonDurationElapsed, WateringStopReasonTargetReached, WateringStopReasonTimeout, WateringStopReasonSafetyLimit, WateringStopReasonLowWaterLevel, WateringStopReasonMaxPulses, WateringStopReasonStopped, WateringStopReasonSensorFault:
+		return true
+	}
+	return false
+}
+
+func (e WateringStopReason) String() string {
+	return string(e)
+}
+
+func (e *WateringStopReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WateringStopReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WateringStopReason", str)
+	}
+	return nil
+}
+
+func (e WateringStopReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ScheduleKind string
+
+const (
+	ScheduleKindAllowedWindow ScheduleKind = "ALLOWED_WINDOW"
+	ScheduleKindQuietHours    ScheduleKind = "QUIET_HOURS"
+	ScheduleKindFixedTime     ScheduleKind = "FIXED_TIME"
+)
+
+var AllScheduleKind = []ScheduleKind{
+	ScheduleKindAllowedWindow,
+	ScheduleKindQuietHours,
+	ScheduleKindFixedTime,
+}
+
+func (e ScheduleKind) IsValid() bool {
+	switch e {
+	case ScheduleKindAllowedWindow, ScheduleKindQuietHours, ScheduleKindFixedTime:
+		return true
+	}
+	return false
+}
+
+func (e ScheduleKind) String() string {
+	return string(e)
+}
+
+func (e *ScheduleKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduleKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduleKind", str)
+	}
+	return nil
+}
+
+func (e ScheduleKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Weekday string
+
+const (
+	WeekdayMonday    Weekday = "MONDAY"
+	WeekdayTuesday   Weekday = "TUESDAY"
+	WeekdayWednesday Weekday = "WEDNESDAY"
+	WeekdayThursday  Weekday = "THURSDAY"
+	WeekdayFriday    Weekday = "FRIDAY"
+	WeekdaySaturday  Weekday = "SATURDAY"
+	WeekdaySunday    Weekday = "SUNDAY"
+)
+
+var AllWeekday = []Weekday{
+	WeekdayMonday,
+	WeekdayTuesday,
+	WeekdayWednesday,
+	WeekdayThursday,
+	WeekdayFriday,
+	WeekdaySaturday,
+	WeekdaySunday,
+}
+
+func (e Weekday) IsValid() bool {
+	switch e {
+	case WeekdayMonday, WeekdayTuesday, WeekdayWednesday, WeekdayThursday, WeekdayFriday, WeekdaySaturday, WeekdaySunday:
+		return true
+	}
+	return false
+}
+
+func (e Weekday) String() string {
+	return string(e)
+}
+
+// Matches reports whether the weekday is the given day of the week.
+func (e Weekday) Matches(day time.Weekday) bool {
+	return string(e) == strings.ToUpper(day.String())
+}
+
+func (e *Weekday) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Weekday(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Weekday", str)
+	}
+	return nil
+}
+
+func (e Weekday) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Role is what a user may do, every role includes the roles listed before it.
+type Role string
+
+const (
+	RoleViewer   Role = "VIEWER"
+	RoleGardener Role = "GARDENER"
+	RoleAdmin    Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleViewer,
+	RoleGardener,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleViewer, RoleGardener, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// Includes reports whether a user with this role may do what the other role may do.
+func (e Role) Includes(other Role) bool {
+	return e.rank() >= other.rank()
+}
+
+func (e Role) rank() int {
+	for i, role := range AllRole {
+		if role == e {
+			return i
+		}
+	}
+	return -1
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WateringPhase string
+
+const (
+	WateringPhaseIdle    WateringPhase = "IDLE"
+	WateringPhaseOpen    WateringPhase = "OPEN"
+	WateringPhaseSoaking WateringPhase = "SOAKING"
+)
+
+var AllWateringPhase = []WateringPhase{
+	WateringPhaseIdle,
+	WateringPhaseOpen,
+	WateringPhaseSoaking,
+}
+
+func (e WateringPhase) IsValid() bool {
+	switch e {
+	case WateringPhaseIdle, WateringPhaseOpen, WateringPhaseSoaking:
+		return true
+	}
+	return false
+}
+
+func (e WateringPhase) String() string {
+	return string(e)
+}
+
+func (e *WateringPhase) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WateringPhase(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WateringPhase", str)
+	}
+	return nil
+}
+
+func (e WateringPhase) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SensorFault string
+
+const (
+	SensorFaultReadErrors SensorFault = "READ_ERRORS"
+	SensorFaultOutOfRange SensorFault = "OUT_OF_RANGE"
+	SensorFaultStuck      SensorFault = "STUCK"
+)
+
+var AllSensorFault = []SensorFault{
+	SensorFaultReadErrors,
+	SensorFaultOutOfRange,
+	SensorFaultStuck,
+}
+
+func (e SensorFault) IsValid() bool {
+	switch e {
+	case SensorFaultReadErrors, SensorFaultOutOfRange, SensorFaultStuck:
+		return true
+	}
+	return false
+}
+
+func (e SensorFault) String() string {
+	return string(e)
+}
+
+func (e *SensorFault) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SensorFault(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SensorFault", str)
+	}
+	return nil
+}
+
+func (e SensorFault) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
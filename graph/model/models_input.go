@@ -6,17 +6,72 @@ type DeletePlantInput struct {
 }
 
 type PlantInput struct {
-	TemplateID uint64 `json:"templateID"`
-	Active     bool   `json:"active"`
-	Name       string `json:"name"`
-	Port       string `json:"port"`
+	TemplateID      uint64   `json:"templateID"`
+	Active          bool     `json:"active"`
+	Name            string   `json:"name"`
+	Port            string   `json:"port"`
+	EvaporationRate *float64 `json:"evaporationRate"`
 }
 
 type PlantTemplateInput struct {
-	Name           string  `json:"name"`
-	WaterThreshold float64 `json:"waterThreshold"`
+	Name           string   `json:"name"`
+	WaterThreshold float64  `json:"waterThreshold"`
+	StopThreshold  *float64 `json:"stopThreshold"`
+	PulseSeconds   *int     `json:"pulseSeconds"`
+	SoakSeconds    *int     `json:"soakSeconds"`
+	MaxPulses      *int     `json:"maxPulses"`
 }
 
 type StationInput struct {
 	Name string `json:"name"`
 }
+
+type CalibrationCurvePointInput struct {
+	Raw        int     `json:"raw"`
+	Percentage float64 `json:"percentage"`
+}
+
+type WateringScheduleInput struct {
+	StationID *uint64      `json:"stationID"`
+	PlantID   *uint64      `json:"plantID"`
+	Kind      ScheduleKind `json:"kind"`
+	Weekdays  []Weekday    `json:"weekdays"`
+	Start     string       `json:"start"`
+	End       *string      `json:"end"`
+	Seconds   *int         `json:"seconds"`
+	Enabled   *bool        `json:"enabled"`
+}
+
+type StationSettingsInput struct {
+	StationID             uint64                   `json:"stationID"`
+	Name                  string                   `json:"name"`
+	GroveBus              string                   `json:"groveBus"`
+	WaterLevelHighAddress int                      `json:"waterLevelHighAddress"`
+	WaterLevelLowAddress  int                      `json:"waterLevelLowAddress"`
+	MoistureAddress       int                      `json:"moistureAddress"`
+	PumpGPIO              int                      `json:"pumpGPIO"`
+	MaxDailyPumpSeconds   *int                     `json:"maxDailyPumpSeconds"`
+	MinWaterLevel         *float64                 `json:"minWaterLevel"`
+	ReservoirAlertLevel   *float64                 `json:"reservoirAlertLevel"`
+	MaxSensorFailures     *int                     `json:"maxSensorFailures"`
+	StuckSensorMinutes    *int                     `json:"stuckSensorMinutes"`
+	Ports                 []*PortSettingsInput     `json:"ports"`
+	Simulation            *SimulationSettingsInput `json:"simulation"`
+	RecordFile            *string                  `json:"recordFile"`
+	ReplayFile            *string                  `json:"replayFile"`
+}
+
+type PortSettingsInput struct {
+	Port            string `json:"port"`
+	MoistureChannel int    `json:"moistureChannel"`
+	ValveGPIO       int    `json:"valveGPIO"`
+	MaxOpenSeconds  *int   `json:"maxOpenSeconds"`
+	CooldownSeconds *int   `json:"cooldownSeconds"`
+}
+
+type SimulationSettingsInput struct {
+	Enabled         bool     `json:"enabled"`
+	EvaporationRate *float64 `json:"evaporationRate"`
+	ValveFlowRate   *float64 `json:"valveFlowRate"`
+	PumpDrainRate   *float64 `json:"pumpDrainRate"`
+}
@@ -0,0 +1,307 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/broker"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/mqtt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mqttBridge publishes the readings, the pumps, the valves and the stations to MQTT and waters and activates plants
+// on commands. Below the topic prefix it uses
+//
+//	status                              online or offline, retained, offline is the last will
+//	station/<id>                        the station as JSON, retained
+//	station/<id>/sensor/<name>[/<port>] the latest reading of a sensor as JSON, retained
+//	station/<id>/pump                   ON or OFF, retained
+//	station/<id>/port/<port>/valve      OPEN or CLOSED, retained
+//	station/<id>/port/<port>/status     the plant status of the port as JSON, retained
+//	plant/<id>/active                   true or false, retained, cleared once the plant is deleted
+//	plant/<id>/active/set               command, true or false activates or deactivates the plant
+//	plant/<id>/water/set                command, seconds like 30 or JSON like {"seconds":30} or {"moisture":60,"timeout":120}
+//	plant/<id>/water/result             the result of a water command as JSON
+type mqttBridge struct {
+	controller *controller
+	client     *mqtt.Client
+
+	// actuators are the last published states of the pumps and valves by topic, so they are only sent when they change
+	actuators      map[string]string
+	actuatorsMutex sync.Mutex
+}
+
+// startMQTT connects to the broker and forwards the topics of the broker to it. It has to run before the stations
+// start, so the bridge does not miss their first readings.
+func (c *controller) startMQTT(config mqtt.Config) error {
+	b := &mqttBridge{
+		controller: c,
+		actuators:  make(map[string]string),
+	}
+
+	client, err := mqtt.Connect(config, b.publishSnapshot)
+	if err != nil {
+		return err
+	}
+	b.client = client
+
+	b.forward(topicStations, func(payload interface{}) {
+		station := payload.(*model.Station)
+		b.publish(b.client.Topic("station", fmt.Sprint(station.ID)), true, station)
+	})
+	b.forward(topicRawReadings, func(payload interface{}) {
+		b.publishReading(payload.(*model.RawReading))
+	})
+	b.forward(topicPlantStatus, func(payload interface{}) {
+		b.publishPlantStatus(payload.(*model.PlantStatus))
+	})
+	b.forward(topicPlants, func(payload interface{}) {
+		change := payload.(*plantChange)
+		if change.Plant == nil {
+			// an empty retained message removes the retained one
+			b.publish(b.client.Topic("plant", fmt.Sprint(change.PlantID), "active"), true, []byte{})
+			return
+		}
+		b.publishActive(change.Plant)
+	})
+
+	if !client.ReadOnly() {
+		client.Subscribe(client.Topic("plant", "+", "water", "set"), b.water)
+		client.Subscribe(client.Topic("plant", "+", "active", "set"), b.activate)
+	}
+
+	return nil
+}
+
+// forward publishes the messages of a broker topic to MQTT. A slow or gone broker only holds up this goroutine,
+// meanwhile newer messages replace the queued ones of the same sensor, station or port.
+func (b *mqttBridge) forward(topic string, publish func(payload interface{})) {
+	sub := b.controller.broker.Subscribe(topic, broker.Options{Policy: broker.Coalesce})
+
+	go sub.Run(context.Background(), func(payload interface{}) bool {
+		publish(payload)
+		return true
+	})
+}
+
+// publish drops messages while the broker is gone, the snapshot after the next connect publishes the state again.
+func (b *mqttBridge) publish(topic string, retained bool, payload interface{}) {
+	if err := b.client.Publish(topic, retained, payload); err != nil && !errors.Is(err, mqtt.ErrNotConnected) {
+		log.Println("could not publish to mqtt", err)
+	}
+}
+
+func (b *mqttBridge) publishReading(reading *model.RawReading) {
+	parts := []string{"station", fmt.Sprint(reading.StationID), "sensor", reading.SensorName}
+	if reading.Port != "" {
+		parts = append(parts, reading.Port)
+	}
+
+	b.publish(b.client.Topic(parts...), true, reading)
+}
+
+func (b *mqttBridge) publishPlantStatus(status *model.PlantStatus) {
+	station := fmt.Sprint(status.StationID)
+
+	b.publish(b.client.Topic("station", station, "port", status.Port, "status"), true, status)
+
+	pump := "OFF"
+	if status.PumpOn {
+		pump = "ON"
+	}
+	b.publishActuator(b.client.Topic("station", station, "pump"), pump)
+
+	valve := "CLOSED"
+	if status.ValveOpen {
+		valve = "OPEN"
+	}
+	b.publishActuator(b.client.Topic("station", station, "port", status.Port, "valve"), valve)
+}
+
+func (b *mqttBridge) publishActuator(topic string, state string) {
+	b.actuatorsMutex.Lock()
+	changed := b.actuators[topic] != state
+	b.actuators[topic] = state
+	b.actuatorsMutex.Unlock()
+
+	if changed {
+		b.publish(topic, true, state)
+	}
+}
+
+func (b *mqttBridge) publishActive(plant *model.Plant) {
+	b.publish(b.client.Topic("plant", fmt.Sprint(plant.ID), "active"), true, strconv.FormatBool(plant.Active))
+}
+
+// publishSnapshot publishes everything again after a connect, the broker may have lost the retained messages
+// and state which changed while the connection was gone was dropped.
+func (b *mqttBridge) publishSnapshot() {
+	c := b.controller
+
+	b.actuatorsMutex.Lock()
+	b.actuators = make(map[string]string)
+	b.actuatorsMutex.Unlock()
+
+	var stations []*model.Station
+	if res := c.db.Find(&stations); res.Error != nil {
+		log.Println("could not load stations", res.Error)
+	}
+	for _, station := range stations {
+		b.publish(b.client.Topic("station", fmt.Sprint(station.ID)), true, station)
+	}
+
+	c.stationsMutex.RLock()
+	running := make([]*station, 0, len(c.stations))
+	for _, s := range c.stations {
+		running = append(running, s)
+	}
+	c.stationsMutex.RUnlock()
+
+	for _, s := range running {
+		for _, reading := range s.RawReadings() {
+			b.publishReading(reading)
+		}
+		for _, status := range s.PlantStatus() {
+			b.publishPlantStatus(status)
+		}
+	}
+
+	var plants []*model.Plant
+	if res := c.db.Find(&plants); res.Error != nil {
+		log.Println("could not load plants", res.Error)
+	}
+	for _, plant := range plants {
+		b.publishActive(plant)
+	}
+}
+
+// plantOf returns the plant id of a command topic like <prefix>/plant/<id>/water/set.
+func (b *mqttBridge) plantOf(topic string) (uint64, error) {
+	parts := strings.Split(strings.TrimPrefix(topic, b.client.Topic("plant")+"/"), "/")
+
+	id, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s has no plant id", topic)
+	}
+	return id, nil
+}
+
+type mqttWaterCommand struct {
+	Seconds  *int     `json:"seconds"`
+	Moisture *float64 `json:"moisture"`
+	Timeout  *int     `json:"timeout"`
+}
+
+type mqttWaterResult struct {
+	PlantID uint64                `json:"plantID"`
+	Result  *model.WateringResult `json:"result,omitempty"`
+	Error   string                `json:"error,omitempty"`
+	Code    ErrorCode             `json:"code,omitempty"`
+}
+
+// water waters the plant like the waterPlant and waterPlantUntil mutations and publishes the result once it is done.
+func (b *mqttBridge) water(topic string, payload []byte) {
+	plantID, err := b.plantOf(topic)
+	if err != nil {
+		log.Println("mqtt:", err)
+		return
+	}
+
+	result, err := b.waterPlant(plantID, payload)
+
+	message := mqttWaterResult{PlantID: plantID, Result: result}
+	if err != nil {
+		log.Println("mqtt: could not water plant", plantID, err)
+		message.Error = err.Error()
+
+		var e *Error
+		if errors.As(err, &e) {
+			message.Code = e.Code
+		}
+	}
+
+	b.publish(b.client.Topic("plant", fmt.Sprint(plantID), "water", "result"), false, message)
+}
+
+func (b *mqttBridge) waterPlant(plantID uint64, payload []byte) (*model.WateringResult, error) {
+	var command mqttWaterCommand
+	text := strings.TrimSpace(string(payload))
+	if seconds, err := strconv.Atoi(text); err == nil {
+		command.Seconds = &seconds
+	} else if err = json.Unmarshal(payload, &command); err != nil {
+		return nil, validationError("payload must be seconds like 30 or JSON like {\"seconds\":30}, not %s", text)
+	}
+
+	v := &validation{}
+	if command.Moisture != nil {
+		v.percentage("moisture", *command.Moisture)
+		v.check(command.Timeout != nil && *command.Timeout > 0, "timeout", "must be greater than 0")
+		if err := v.err(); err != nil {
+			return nil, err
+		}
+
+		return b.controller.WaterPlant(context.Background(), plantID, time.Duration(*command.Timeout)*time.Second, command.Moisture)
+	}
+
+	v.check(command.Seconds != nil && *command.Seconds > 0, "seconds", "must be greater than 0")
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	return b.controller.WaterPlant(context.Background(), plantID, time.Duration(*command.Seconds)*time.Second, nil)
+}
+
+// activate activates or deactivates the plant like the updatePlant mutation, the active topic always tells
+// the state afterwards, so switches of a home automation turn back if the command failed.
+func (b *mqttBridge) activate(topic string, payload []byte) {
+	plantID, err := b.plantOf(topic)
+	if err != nil {
+		log.Println("mqtt:", err)
+		return
+	}
+
+	c := b.controller
+	var plant model.Plant
+	if err = findByID(c.db, &plant, "plant", plantID); err != nil {
+		log.Println("mqtt: could not activate plant", plantID, err)
+		return
+	}
+
+	active, err := parseSwitch(string(payload))
+	if err == nil {
+		input := model.PlantInput{
+			TemplateID:      plant.TemplateID,
+			Active:          active,
+			Name:            plant.Name,
+			Port:            plant.Port,
+			EvaporationRate: &plant.EvaporationRate,
+		}
+		if err = plantFromInput(c, plant.StationID, input, &plant); err == nil {
+			err = c.db.Save(&plant).Error
+		}
+	}
+	if err != nil {
+		log.Println("mqtt: could not activate plant", plantID, err)
+		c.db.Limit(1).Find(&plant, plantID)
+		b.publishActive(&plant)
+		return
+	}
+
+	c.PlantChanged(&plant)
+}
+
+func parseSwitch(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "on", "1":
+		return true, nil
+	case "false", "off", "0":
+		return false, nil
+	}
+	return false, validationError("payload must be true or false, not %s", value)
+}
@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/mqtt"
+	"github.com/ZamarianPatrick/lazypig-backend/mqtt/mqtttest"
+	"testing"
+	"time"
+)
+
+const mqttTimeout = 5 * time.Second
+
+func TestMQTTBridge(t *testing.T) {
+	broker, err := mqtttest.NewBroker()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		broker.Close()
+	})
+
+	tc := newTestControllerWithOptions(t, Options{MQTT: mqtt.Config{Broker: broker.URL()}}, testStation(1, false))
+	r := &mutationResolver{&Resolver{controller: tc.controller, clock: tc.clock}}
+	ctx := context.Background()
+
+	waitRetained := func(topic string, payload string) {
+		t.Helper()
+		if !broker.WaitRetained("lazypig/"+topic, payload, mqttTimeout) {
+			retained, _ := broker.Retained("lazypig/" + topic)
+			t.Fatalf("%s is %q, want %q", topic, retained, payload)
+		}
+	}
+
+	template := &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}
+	if err = tc.db.Create(template).Error; err != nil {
+		t.Fatal(err)
+	}
+	input := model.PlantInput{TemplateID: template.ID, Active: true, Name: "Basil", Port: "A"}
+	plant, err := r.CreatePlant(ctx, 1, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plantTopic := fmt.Sprintf("plant/%d", plant.ID)
+	waitRetained(plantTopic+"/active", "true")
+
+	tc.advance(time.Second)
+	waitRetained("status", mqtt.StatusOnline)
+	waitRetained("station/1/pump", "OFF")
+	waitRetained("station/1/port/A/valve", "CLOSED")
+	if !broker.Wait(mqttTimeout, func() bool {
+		_, ok := broker.Retained("lazypig/station/1/sensor/Moisture/A")
+		return ok
+	}) {
+		t.Error("moisture reading was not published")
+	}
+
+	if !broker.Wait(mqttTimeout, func() bool { return broker.Subscribed("lazypig/plant/+/water/set") == 1 }) {
+		t.Fatal("bridge did not subscribe to the water commands")
+	}
+	broker.Publish("lazypig/"+plantTopic+"/water/set", []byte(`{"seconds":2}`), false)
+	waitRetained("station/1/port/A/valve", "OPEN")
+	waitRetained("station/1/pump", "ON")
+
+	tc.advance(time.Second)
+	tc.advance(time.Second)
+	waitRetained("station/1/port/A/valve", "CLOSED")
+	message, ok := broker.WaitMessage("lazypig/"+plantTopic+"/water/result", mqttTimeout)
+	if !ok {
+		t.Fatal("water command got no result")
+	}
+	var result mqttWaterResult
+	if err = json.Unmarshal(message.Payload, &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Error != "" || result.Result == nil || result.PlantID != plant.ID {
+		t.Errorf("got result %s, want the watering of plant %d", message.Payload, plant.ID)
+	}
+
+	// changes through GraphQL and through MQTT both end up in the active topic
+	input.Active = false
+	if _, err = r.UpdatePlant(ctx, plant.ID, 1, input); err != nil {
+		t.Fatal(err)
+	}
+	waitRetained(plantTopic+"/active", "false")
+
+	broker.Publish("lazypig/"+plantTopic+"/active/set", []byte("on"), false)
+	waitRetained(plantTopic+"/active", "true")
+	var stored model.Plant
+	tc.db.First(&stored, plant.ID)
+	if !stored.Active {
+		t.Error("activated plant is not active in the database")
+	}
+
+	// a second active plant on the port is refused, its topic turns back
+	other, err := r.CreatePlant(ctx, 1, model.PlantInput{TemplateID: template.ID, Name: "Mint", Port: "A"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherTopic := fmt.Sprintf("plant/%d/active", other.ID)
+	waitRetained(otherTopic, "false")
+	broker.Publish("lazypig/"+otherTopic+"/set", []byte("true"), false)
+	if !broker.Wait(mqttTimeout, func() bool { return len(broker.Messages("lazypig/"+otherTopic)) == 2 }) {
+		t.Fatal("refused activation was not answered")
+	}
+	waitRetained(otherTopic, "false")
+
+	if _, err = r.DeletePlant(ctx, plant.ID); err != nil {
+		t.Fatal(err)
+	}
+	waitRetained(plantTopic+"/active", "")
+}
@@ -0,0 +1,24 @@
+package graph
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 500
+	// maxReadingBuckets is the most buckets a readings query returns
+	maxReadingBuckets = 10000
+)
+
+// pagination resolves the optional offset and limit arguments of paginated queries, limits above maxPageLimit are capped.
+func pagination(offset *int, limit *int) (int, int) {
+	o, l := 0, defaultPageLimit
+	if offset != nil && *offset > 0 {
+		o = *offset
+	}
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+	if l > maxPageLimit {
+		l = maxPageLimit
+	}
+
+	return o, l
+}
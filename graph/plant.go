@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"strings"
+)
+
+// plantFromInput validates the input and applies it to the plant, existing plants have to carry their id already.
+// An active plant needs a port of its station which no other active plant uses.
+func plantFromInput(c Controller, stationID uint64, input model.PlantInput, plant *model.Plant) error {
+	var station model.Station
+	if err := findByID(c.DB(), &station, "station", stationID); err != nil {
+		return err
+	}
+
+	var template model.PlantTemplate
+	if err := findByID(c.DB(), &template, "template", input.TemplateID); err != nil {
+		return err
+	}
+
+	ports, err := c.PossibleStationPorts(stationID)
+	if err != nil {
+		return err
+	}
+
+	v := &validation{}
+	v.notEmpty("input.name", input.Name)
+	v.check(containsString(ports, input.Port), "input.port", "station %d has no port %s, it has %s", stationID, input.Port, strings.Join(ports, ", "))
+
+	evaporationRate := 0.0
+	if input.EvaporationRate != nil {
+		evaporationRate = *input.EvaporationRate
+		v.notNegative("input.evaporationRate", evaporationRate)
+	}
+
+	if err = v.err(); err != nil {
+		return err
+	}
+
+	if input.Active {
+		var other model.Plant
+		res := c.DB().Where("station_id = ? AND port = ? AND active = ? AND id <> ?", stationID, input.Port, true, plant.ID).Limit(1).Find(&other)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected > 0 {
+			return fieldConflict("input.port", "port %s of station %d is already used by the active plant %d", input.Port, stationID, other.ID)
+		}
+	}
+
+	plant.StationID = stationID
+	plant.Name = input.Name
+	plant.Active = input.Active
+	plant.Port = input.Port
+	plant.TemplateID = template.ID
+	plant.Template = template
+	plant.EvaporationRate = evaporationRate
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/broker"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"sort"
+)
+
+// statusOf returns the status of a port as the loop of the station sees it right now.
+func (s *station) statusOf(state *plantState) *model.PlantStatus {
+	status := &model.PlantStatus{
+		StationID: s.id,
+		Port:      state.port.Port,
+		Thirsty:   state.thirsty,
+		ValveOpen: state.valveOpen(),
+		Phase:     model.WateringPhaseIdle,
+		PumpOn:    s.pump.IsOn(),
+		Status:    state.status,
+		Timestamp: s.clock.Now().UTC(),
+	}
+
+	switch state.phase {
+	case wateringOpen:
+		status.Phase = model.WateringPhaseOpen
+	case wateringSoaking:
+		status.Phase = model.WateringPhaseSoaking
+	}
+
+	if state.activePlantID != 0 {
+		plantID := state.activePlantID
+		status.PlantID = &plantID
+	}
+
+	if state.measured {
+		moisture := state.currentMoisture
+		status.Moisture = &moisture
+	}
+
+	if s.lastWaterLevel >= 0 {
+		waterLevel := s.lastWaterLevel
+		status.WaterLevel = &waterLevel
+	}
+
+	status.ThirstyWithoutWater = state.thirsty && s.lastWaterLevel < s.settings.MinWaterLevel
+
+	s.healthMutex.RLock()
+	if health, ok := s.health[sensorKey(sensors.MoistureSensorName, state.port.Port)]; ok && health.Faulted() {
+		fault := model.SensorFault(health.Fault)
+		status.SensorFault = &fault
+	}
+	s.healthMutex.RUnlock()
+
+	return status
+}
+
+// publishPlantStatus sends the status of every port which changed since it was sent last.
+func (s *station) publishPlantStatus() {
+	for port, state := range s.plantStates {
+		status := s.statusOf(state)
+
+		s.statusMutex.Lock()
+		previous := s.plantStatus[port]
+		changed := previous == nil || !samePlantStatus(previous, status)
+		if changed {
+			s.plantStatus[port] = status
+		}
+		s.statusMutex.Unlock()
+
+		if changed {
+			s.controller.publishPlantStatus(status)
+		}
+	}
+}
+
+// PlantStatus returns the latest status of every port of the station, sorted by port.
+func (s *station) PlantStatus() []*model.PlantStatus {
+	s.statusMutex.RLock()
+	defer s.statusMutex.RUnlock()
+
+	statuses := make([]*model.PlantStatus, 0, len(s.plantStatus))
+	for _, status := range s.plantStatus {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Port < statuses[j].Port
+	})
+
+	return statuses
+}
+
+// samePlantStatus compares two statuses without their timestamps.
+func samePlantStatus(a *model.PlantStatus, b *model.PlantStatus) bool {
+	return a.StationID == b.StationID &&
+		a.Port == b.Port &&
+		equalUint64(a.PlantID, b.PlantID) &&
+		equalFloat(a.Moisture, b.Moisture) &&
+		a.Thirsty == b.Thirsty &&
+		a.ValveOpen == b.ValveOpen &&
+		a.Phase == b.Phase &&
+		a.PumpOn == b.PumpOn &&
+		equalFloat(a.WaterLevel, b.WaterLevel) &&
+		a.ThirstyWithoutWater == b.ThirstyWithoutWater &&
+		equalSensorFault(a.SensorFault, b.SensorFault) &&
+		a.Status == b.Status
+}
+
+func equalUint64(a *uint64, b *uint64) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func equalSensorFault(a *model.SensorFault, b *model.SensorFault) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func equalFloat(a *float64, b *float64) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func (c *controller) publishPlantStatus(status *model.PlantStatus) {
+	c.broker.Publish(topicPlantStatus, plantStatusKey(status), status)
+}
+
+// plantStatusKey tells the ports apart, a subscriber which falls behind only gets the latest status of a port.
+func plantStatusKey(status *model.PlantStatus) string {
+	return fmt.Sprintf("%d/%s", status.StationID, status.Port)
+}
+
+func (c *controller) PlantStatus(stationID uint64) ([]*model.PlantStatus, error) {
+	s, err := c.station(stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.PlantStatus(), nil
+}
+
+// PlantStatusChannel subscribes to the status of the ports of a station. The current status of every port is queued first,
+// so a client does not have to wait for the next change. The subscription survives a reload of the station settings.
+func (c *controller) PlantStatusChannel(ctx context.Context, stationID uint64) (chan *model.PlantStatus, error) {
+	s, err := c.station(stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *model.PlantStatus)
+	sub := c.broker.Subscribe(topicPlantStatus, broker.Options{
+		Policy: broker.Coalesce,
+		Filter: func(payload interface{}) bool {
+			return payload.(*model.PlantStatus).StationID == stationID
+		},
+	})
+	// subscribed first, so no change gets lost, a change in between replaces the status of the port queued here
+	for _, status := range s.PlantStatus() {
+		sub.Send(plantStatusKey(status), status)
+	}
+
+	go forward(ctx, sub, func(payload interface{}) bool {
+		select {
+		case ch <- payload.(*model.PlantStatus):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(ch) })
+
+	return ch, nil
+}
@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"context"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"testing"
+	"time"
+)
+
+// waitForStatus reads statuses from the subscription until one matches, changes in between may have been coalesced.
+func waitForStatus(t *testing.T, ch <-chan *model.PlantStatus, description string, matches func(*model.PlantStatus) bool) *model.PlantStatus {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case status, ok := <-ch:
+			if !ok {
+				t.Fatalf("subscription closed while waiting for %s", description)
+			}
+			if matches(status) {
+				return status
+			}
+		case <-timeout:
+			t.Fatalf("got no status %s", description)
+		}
+	}
+}
+
+func TestPlantStatusSubscriptionStreamsTheLoop(t *testing.T) {
+	tc := newTestController(t, testStation(1, false), testStation(2, false))
+	r := &subscriptionResolver{&Resolver{controller: tc.controller, clock: tc.clock}}
+
+	plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	tc.setMoisture(1, "A", 50)
+	tc.advance(time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := r.PlantStatus(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the current status comes first, without waiting for a change
+	status := waitForStatus(t, ch, "of port A", func(*model.PlantStatus) bool { return true })
+	if status.StationID != 1 || status.Port != "A" || status.PlantID == nil || *status.PlantID != plant.ID || status.Moisture == nil || *status.Moisture != 50 {
+		t.Errorf("got first status %+v, want the one of plant %d at 50", status, plant.ID)
+	}
+
+	// changes of another station are not sent
+	tc.setMoisture(2, "A", 10)
+	tc.setMoisture(1, "A", 30)
+	tc.advance(time.Second)
+	status = waitForStatus(t, ch, "with the valve open", func(s *model.PlantStatus) bool {
+		if s.StationID != 1 {
+			t.Errorf("got status %+v of station %d", s, s.StationID)
+		}
+		return s.ValveOpen
+	})
+	if !status.Thirsty || !status.PumpOn || status.Phase != model.WateringPhaseOpen {
+		t.Errorf("got status %+v, want a thirsty plant of station 1 watered with the pump on", status)
+	}
+
+	tc.setWaterLevel(1, 2)
+	tc.advance(time.Second)
+	status = waitForStatus(t, ch, "thirsty without water", func(s *model.PlantStatus) bool { return s.ThirstyWithoutWater })
+	if status.ValveOpen || status.PumpOn || status.WaterLevel == nil || *status.WaterLevel != 2 {
+		t.Errorf("got status %+v, want the valve and the pump off at a water level of 2", status)
+	}
+
+	cancel()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("subscription was not closed with its context")
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"context"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"testing"
+	"time"
+)
+
+func TestReadingsAreBucketedWithinTheRange(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	r := &queryResolver{&Resolver{controller: tc.controller, clock: tc.clock}}
+
+	template := &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}
+	previous := tc.createPlant(1, "A", template, 0)
+	if err := tc.db.Model(previous).Update("active", false).Error; err != nil {
+		t.Fatal(err)
+	}
+	plant := tc.createPlant(1, "A", template, 0)
+
+	// far from the readings the running station stores
+	from := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(3 * time.Minute)
+
+	store := func(at time.Duration, value float64, plantID *uint64, sensorName string, port string) {
+		t.Helper()
+		reading := &model.Reading{StationID: 1, SensorName: sensorName, Port: port, PlantID: plantID, Value: value, Timestamp: from.Add(at)}
+		if err := tc.db.Create(reading).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	moisture := func(at time.Duration, value float64, plantID *uint64) {
+		store(at, value, plantID, sensors.MoistureSensorName, "A")
+	}
+
+	moisture(-time.Second, 1, &plant.ID)
+	moisture(0, 10, &plant.ID)
+	moisture(30*time.Second, 20, &plant.ID)
+	moisture(59*time.Second, 30, &plant.ID)
+	moisture(time.Minute, 40, &plant.ID)
+	// readings stored before they got a plant belong to the port
+	moisture(90*time.Second, 60, nil)
+	moisture(3*time.Minute-time.Second, 70, &plant.ID)
+	moisture(3*time.Minute, 80, &plant.ID)
+	// readings of the plant which was on the port before, of other sensors and of other ports are left out
+	moisture(10*time.Second, 99, &previous.ID)
+	store(10*time.Second, 99, nil, sensors.WaterLevelSensorName, "")
+	store(10*time.Second, 99, nil, sensors.MoistureSensorName, "B")
+
+	buckets, err := r.Readings(context.Background(), plant.ID, from, to, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []model.ReadingBucket{
+		{From: from, To: from.Add(time.Minute), Min: 10, Max: 30, Average: 20, Count: 3},
+		{From: from.Add(time.Minute), To: from.Add(2 * time.Minute), Min: 40, Max: 60, Average: 50, Count: 2},
+		{From: from.Add(2 * time.Minute), To: to, Min: 70, Max: 70, Average: 70, Count: 1},
+	}
+	if len(buckets) != len(want) {
+		t.Fatalf("got %d buckets, want %d", len(buckets), len(want))
+	}
+	for i, b := range buckets {
+		if !b.From.Equal(want[i].From) || !b.To.Equal(want[i].To) || b.Min != want[i].Min || b.Max != want[i].Max ||
+			b.Average != want[i].Average || b.Count != want[i].Count {
+			t.Errorf("got bucket %+v, want %+v", *b, want[i])
+		}
+	}
+}
+
+func TestReadingsNeedAValidRange(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	r := &queryResolver{&Resolver{controller: tc.controller, clock: tc.clock}}
+	plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+
+	from := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	for _, c := range []struct {
+		to         time.Time
+		resolution int
+	}{
+		{from.Add(time.Hour), 0},
+		{from, 60},
+		{from.Add(-time.Hour), 60},
+		{from.Add(24 * time.Hour), 1},
+	} {
+		if _, err := r.Readings(context.Background(), plant.ID, from, c.to, c.resolution); Code(err) != ErrorCodeValidation {
+			t.Errorf("readings until %s in buckets of %ds returned %v, want a validation error", c.to, c.resolution, err)
+		}
+	}
+
+	if _, err := r.Readings(context.Background(), plant.ID+1, from, from.Add(time.Hour), 60); Code(err) != ErrorCodeNotFound {
+		t.Errorf("readings of an unknown plant returned %v, want not found", err)
+	}
+}
@@ -4,21 +4,37 @@ package graph
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
+	"time"
+)
+
 type Resolver struct {
-	version    string
-	controller Controller
+	version         string
+	controller      Controller
+	sessionLifetime time.Duration
+	clock           clock.Clock
 }
 
-func NewResolver(version string) (*Resolver, error) {
+func NewResolver(version string, options Options) (*Resolver, error) {
+	if options.Clock == nil {
+		options.Clock = clock.Real()
+	}
 
-	controller, err := NewController(true)
+	controller, err := NewController(options)
 	if err != nil {
 		return nil, err
 	}
 
+	if options.SessionLifetime <= 0 {
+		options.SessionLifetime = DefaultSessionLifetime
+	}
+
 	r := &Resolver{
-		controller: controller,
-		version:    version,
+		controller:      controller,
+		version:         version,
+		sessionLifetime: options.SessionLifetime,
+		clock:           options.Clock,
 	}
 
 	return r, nil
@@ -0,0 +1,250 @@
+package graph
+
+import (
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"time"
+)
+
+// scheduleFromInput validates the input and applies it to the schedule. Schedules of a plant always carry the station of the plant.
+func scheduleFromInput(c Controller, input model.WateringScheduleInput, schedule *model.WateringSchedule) error {
+	v := &validation{}
+	v.check((input.StationID == nil) != (input.PlantID == nil), "input.stationID", "a schedule needs either a station or a plant")
+	v.check(input.Kind.IsValid(), "input.kind", "%s is not a valid schedule kind", input.Kind)
+
+	if _, err := parseClock(input.Start); err != nil {
+		v.add("input.start", err.Error())
+	}
+
+	if input.Kind == model.ScheduleKindFixedTime {
+		v.check(input.Seconds != nil && *input.Seconds > 0, "input.seconds", "a fixed time watering needs seconds greater than 0")
+	} else if v.check(input.End != nil, "input.end", "a window needs an end") {
+		if _, err := parseClock(*input.End); err != nil {
+			v.add("input.end", err.Error())
+		}
+	}
+
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	if input.PlantID != nil {
+		var plant model.Plant
+		if err := findByID(c.DB(), &plant, "plant", *input.PlantID); err != nil {
+			return err
+		}
+
+		schedule.StationID = plant.StationID
+		schedule.PlantID = input.PlantID
+	} else {
+		if _, err := c.PossibleStationPorts(*input.StationID); err != nil {
+			return err
+		}
+
+		schedule.StationID = *input.StationID
+		schedule.PlantID = nil
+	}
+
+	schedule.Kind = input.Kind
+	schedule.Start = input.Start
+	schedule.End = ""
+	schedule.Seconds = 0
+	schedule.Weekdays = input.Weekdays
+	if schedule.Weekdays == nil {
+		schedule.Weekdays = []model.Weekday{}
+	}
+
+	schedule.Enabled = true
+	if input.Enabled != nil {
+		schedule.Enabled = *input.Enabled
+	}
+
+	if input.Kind == model.ScheduleKindFixedTime {
+		schedule.Seconds = *input.Seconds
+	} else {
+		schedule.End = *input.End
+	}
+
+	return nil
+}
+
+// parseClock returns the minutes since midnight of a time formatted as 15:04.
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, validationError("%s is not a time formatted as HH:MM", value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// onWeekday reports whether the schedule applies on the weekday of t, schedules without weekdays apply every day.
+func onWeekday(schedule *model.WateringSchedule, t time.Time) bool {
+	if len(schedule.Weekdays) == 0 {
+		return true
+	}
+
+	for _, day := range schedule.Weekdays {
+		if day.Matches(t.Weekday()) {
+			return true
+		}
+	}
+	return false
+}
+
+// windowCovers reports whether t lies within the window of the schedule.
+// The weekdays refer to the day a window starts at, so a window spanning midnight goes on into the next day.
+func windowCovers(schedule *model.WateringSchedule, t time.Time) bool {
+	start, err := parseClock(schedule.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(schedule.End)
+	if err != nil {
+		return false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+
+	switch {
+	case start == end:
+		return onWeekday(schedule, t)
+	case start < end:
+		return minute >= start && minute < end && onWeekday(schedule, t)
+	default:
+		return (minute >= start && onWeekday(schedule, t)) || (minute < end && onWeekday(schedule, t.AddDate(0, 0, -1)))
+	}
+}
+
+// schedules returns the enabled schedules of the given kind for the station and, if plantID is not 0, for the plant.
+func (s *station) schedules(kind model.ScheduleKind, plantID uint64) []*model.WateringSchedule {
+	var schedules []*model.WateringSchedule
+
+	query := s.controller.db.Where("station_id = ? AND kind = ? AND enabled = ?", s.id, kind, true)
+	if plantID != 0 {
+		query = query.Where("plant_id IS NULL OR plant_id = ?", plantID)
+	}
+	query.Find(&schedules)
+
+	return schedules
+}
+
+// wateringAllowed reports whether a threshold watering of the plant may start at t.
+// Windows of the plant replace those of the station, quiet hours of both apply.
+func wateringAllowed(schedules []*model.WateringSchedule, quietHours []*model.WateringSchedule, plantID uint64, t time.Time) bool {
+	for _, quiet := range quietHours {
+		if windowCovers(quiet, t) {
+			return false
+		}
+	}
+
+	var plantWindows, stationWindows []*model.WateringSchedule
+	for _, schedule := range schedules {
+		if schedule.PlantID != nil && *schedule.PlantID == plantID {
+			plantWindows = append(plantWindows, schedule)
+		} else if schedule.PlantID == nil {
+			stationWindows = append(stationWindows, schedule)
+		}
+	}
+
+	windows := plantWindows
+	if len(windows) == 0 {
+		windows = stationWindows
+	}
+	if len(windows) == 0 {
+		return true
+	}
+
+	for _, window := range windows {
+		if windowCovers(window, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextAllowedWatering returns when a threshold watering of the plant may start next, or false if that is not within a week.
+func (s *station) nextAllowedWatering(plantID uint64, now time.Time) (time.Time, bool) {
+	windows := s.schedules(model.ScheduleKindAllowedWindow, plantID)
+	quietHours := s.schedules(model.ScheduleKindQuietHours, plantID)
+
+	t := now
+	for i := 0; i <= 7*24*60; i++ {
+		if wateringAllowed(windows, quietHours, plantID, t) {
+			return t, true
+		}
+		t = t.Truncate(time.Minute).Add(time.Minute)
+	}
+
+	return time.Time{}, false
+}
+
+// maxScheduleCatchUp is how long after their minute fixed time waterings are started late, when the loop was held up
+// or the clock jumped. Older ones are skipped, after a jump of hours all the waterings in between would run at once.
+const maxScheduleCatchUp = 15 * time.Minute
+
+// runFixedSchedules starts the fixed time waterings which were due since it ran the last time, so a loop iteration
+// does not have to land in the minute of a schedule. On its first run it only looks at the current minute.
+// They ignore the moisture, cooldown and quiet hours, but not the water level guard and the daily pump limit.
+func (s *station) runFixedSchedules() {
+	minute := s.clock.Now().Truncate(time.Minute)
+	if !minute.After(s.lastScheduleMinute) {
+		return
+	}
+
+	from := s.lastScheduleMinute.Add(time.Minute)
+	if s.lastScheduleMinute.IsZero() || minute.Sub(from) > maxScheduleCatchUp {
+		from = minute
+	}
+	s.lastScheduleMinute = minute
+
+	for _, schedule := range s.schedules(model.ScheduleKindFixedTime, 0) {
+		if !fixedScheduleDue(schedule, from, minute) {
+			continue
+		}
+
+		var plants []model.Plant
+		query := s.controller.db.Preload("Template").Where("station_id = ? AND active = ?", s.id, true)
+		if schedule.PlantID != nil {
+			query = query.Where("id = ?", *schedule.PlantID)
+		}
+		query.Find(&plants)
+
+		for i := range plants {
+			plant := &plants[i]
+			state, ok := s.plantStates[plant.Port]
+			if !ok {
+				continue
+			}
+
+			if state.active() {
+				s.logStatus(state, "scheduled watering skipped, plant is already watering")
+				continue
+			}
+
+			if reason := s.pumpBlocked(); reason != "" {
+				s.logStatus(state, "scheduled watering skipped, "+reason)
+				continue
+			}
+
+			duration := time.Duration(schedule.Seconds) * time.Second
+			maxOpen := time.Duration(state.port.MaxOpenSeconds) * time.Second
+			if duration > maxOpen {
+				duration = maxOpen
+			}
+
+			s.logStatus(state, fmt.Sprintf("scheduled watering for %s", duration))
+			s.startTimedWatering(state, plant, model.WateringReasonSchedule, duration, nil, nil)
+		}
+	}
+}
+
+// fixedScheduleDue reports whether the schedule is due in a minute from from to to, both included.
+func fixedScheduleDue(schedule *model.WateringSchedule, from time.Time, to time.Time) bool {
+	for t := from; !t.After(to); t = t.Add(time.Minute) {
+		if schedule.Start == t.Format("15:04") && onWeekday(schedule, t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"testing"
+	"time"
+)
+
+func TestFixedScheduleDue(t *testing.T) {
+	// testStart is a Monday
+	schedule := &model.WateringSchedule{
+		Kind:     model.ScheduleKindFixedTime,
+		Weekdays: []model.Weekday{model.WeekdayMonday},
+		Start:    "06:32",
+	}
+	at := func(hour, minute int) time.Time {
+		return testStart.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+
+	for _, c := range []struct {
+		from, to time.Time
+		due      bool
+	}{
+		{at(6, 32), at(6, 32), true},
+		{at(6, 30), at(6, 35), true},
+		{at(6, 33), at(6, 40), false},
+		{at(6, 20), at(6, 31), false},
+		{at(6, 30).AddDate(0, 0, 1), at(6, 35).AddDate(0, 0, 1), false},
+	} {
+		if due := fixedScheduleDue(schedule, c.from, c.to); due != c.due {
+			t.Errorf("schedule due from %s to %s is %v, want %v", c.from.Format("Mon 15:04"), c.to.Format("Mon 15:04"), due, c.due)
+		}
+	}
+}
+
+func (tc *testController) createSchedule(schedule *model.WateringSchedule) {
+	tc.t.Helper()
+
+	schedule.Enabled = true
+	if schedule.Weekdays == nil {
+		schedule.Weekdays = []model.Weekday{}
+	}
+	if err := tc.db.Create(schedule).Error; err != nil {
+		tc.t.Fatal(err)
+	}
+}
+
+func TestThirstyPlantIsQueuedDuringQuietHours(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	board := tc.board(1)
+
+	tc.createSchedule(&model.WateringSchedule{StationID: 1, Kind: model.ScheduleKindQuietHours, Start: "00:00", End: "06:00"})
+	plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	tc.setMoisture(1, "A", 30)
+
+	tc.advance(time.Second)
+	assertRelays(t, board, false, false)
+	tc.inLoop(1, func(s *station) {
+		if status := s.plantStates["A"].status; status != "plant is thirsty, watering queued until Mon 06:00" {
+			t.Errorf("got status %q, want the watering queued until the quiet hours end", status)
+		}
+	})
+
+	tc.run(testStart.Add(6*time.Hour-time.Second), time.Minute)
+	assertRelays(t, board, false, false)
+	if events := tc.wateringEvents(plant.ID); len(events) != 0 {
+		t.Fatalf("got %d watering events during the quiet hours, want none", len(events))
+	}
+
+	tc.advance(2 * time.Second)
+	assertRelays(t, board, true, true)
+	events := tc.wateringEvents(plant.ID)
+	if len(events) != 1 || events[0].StartedAt.Before(testStart.Add(6*time.Hour)) {
+		t.Errorf("got watering events %+v, want one started at 06:00", events)
+	}
+}
+
+func TestFixedScheduleCatchesUpAtMost15Minutes(t *testing.T) {
+	for _, c := range []struct {
+		jump    time.Duration
+		started bool
+	}{
+		{12 * time.Minute, true},
+		// the loop ran at 00:00 the last time, a run at 00:16 looks back at 15 minutes from 00:01 on
+		{16 * time.Minute, true},
+		{17 * time.Minute, false},
+	} {
+		c := c
+		t.Run(c.jump.String(), func(t *testing.T) {
+			tc := newTestController(t, testStation(1, false))
+			tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+			tc.createSchedule(&model.WateringSchedule{StationID: 1, Kind: model.ScheduleKindFixedTime, Start: "00:01", Seconds: 5})
+
+			// the jump happens within a single iteration, so the loop does not get to run in the minutes in between
+			tc.inLoop(1, func(s *station) {
+				tc.clock.Advance(c.jump)
+				s.runFixedSchedules()
+
+				if started := s.plantStates["A"].timed != nil; started != c.started {
+					t.Errorf("after a jump of %s the 00:01 watering started: %v, want %v", c.jump, started, c.started)
+				}
+			})
+		})
+	}
+}
+
+func TestNextAllowedWatering(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return testStart.AddDate(0, 0, day).Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+
+	for _, c := range []struct {
+		name      string
+		schedules []*model.WateringSchedule
+		now       time.Time
+		next      time.Time
+		ok        bool
+	}{
+		{"without schedules right away", nil, at(0, 3, 0).Add(30 * time.Second), at(0, 3, 0).Add(30 * time.Second), true},
+		{"at the start of the next minute", []*model.WateringSchedule{
+			{Kind: model.ScheduleKindQuietHours, Start: "03:00", End: "03:01"},
+		}, at(0, 3, 0).Add(30 * time.Second), at(0, 3, 1), true},
+		{"in a window days later", []*model.WateringSchedule{
+			{Kind: model.ScheduleKindAllowedWindow, Start: "22:00", End: "23:00", Weekdays: []model.Weekday{model.WeekdayWednesday}},
+		}, at(0, 3, 0), at(2, 22, 0), true},
+		{"after quiet hours within the window", []*model.WateringSchedule{
+			{Kind: model.ScheduleKindAllowedWindow, Start: "22:00", End: "23:00", Weekdays: []model.Weekday{model.WeekdayWednesday}},
+			{Kind: model.ScheduleKindQuietHours, Start: "21:00", End: "22:30"},
+		}, at(0, 3, 0), at(2, 22, 30), true},
+		{"a week later in the window which just closed", []*model.WateringSchedule{
+			{Kind: model.ScheduleKindAllowedWindow, Start: "02:00", End: "03:00", Weekdays: []model.Weekday{model.WeekdayMonday}},
+		}, at(0, 3, 0), at(7, 2, 0), true},
+		{"never if quiet hours cover every window", []*model.WateringSchedule{
+			{Kind: model.ScheduleKindAllowedWindow, Start: "22:00", End: "23:00", Weekdays: []model.Weekday{model.WeekdayWednesday}},
+			{Kind: model.ScheduleKindQuietHours, Start: "21:00", End: "23:30"},
+		}, at(0, 3, 0), time.Time{}, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			tc := newTestController(t, testStation(1, false))
+			plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+			for _, schedule := range c.schedules {
+				schedule.StationID = 1
+				tc.createSchedule(schedule)
+			}
+
+			tc.inLoop(1, func(s *station) {
+				next, ok := s.nextAllowedWatering(plant.ID, c.now)
+				if ok != c.ok || !next.Equal(c.next) {
+					t.Errorf("got %s %v, want %s %v", next.Format("Mon 15:04:05"), ok, c.next.Format("Mon 15:04:05"), c.ok)
+				}
+			})
+		})
+	}
+}
@@ -5,35 +5,331 @@ package graph
 
 import (
 	"context"
+	"github.com/ZamarianPatrick/lazypig-backend/auth"
 	"github.com/ZamarianPatrick/lazypig-backend/graph/generated"
 	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"math"
+	"time"
 )
 
+func (r *mutationResolver) Login(ctx context.Context, username string, password string) (*model.AuthPayload, error) {
+	var user model.User
+	res := r.controller.DB().Where("username = ?", username).Limit(1).Find(&user)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	// the password is checked against a dummy hash for unknown users, so the time of the response does not tell they do not exist
+	hash := auth.UnknownUserHash
+	if res.RowsAffected > 0 {
+		hash = user.PasswordHash
+	}
+	if auth.CheckPassword(hash, password) != nil || res.RowsAffected == 0 {
+		return nil, unauthenticated("%w", auth.ErrInvalidCredentials)
+	}
+
+	token, err := auth.NewToken(auth.SessionPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.clock.Now()
+	session := &model.Session{
+		UserID:    user.ID,
+		TokenHash: auth.HashToken(token),
+		ExpiresAt: now.Add(r.sessionLifetime),
+	}
+
+	r.controller.DB().Where("expires_at <= ?", now).Delete(&model.Session{})
+	res = r.controller.DB().Create(session)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &model.AuthPayload{
+		Token:     token,
+		ExpiresAt: session.ExpiresAt,
+		User:      &user,
+	}, nil
+}
+
+func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
+	identity, err := identityOf(ctx)
+	if err != nil {
+		return false, err
+	}
+	if identity.SessionID == 0 {
+		return false, conflict("the request was authenticated by an API key, revoke it with revokeAPIKey")
+	}
+
+	res := r.controller.DB().Delete(&model.Session{}, identity.SessionID)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) CreateUser(ctx context.Context, username string, password string, role *model.Role) (*model.User, error) {
+	v := &validation{}
+	v.notEmpty("username", username)
+	v.check(len(password) >= auth.MinPasswordLength, "password", "must have at least %d characters", auth.MinPasswordLength)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &model.User{
+		Username:     username,
+		PasswordHash: hash,
+	}
+
+	// counting and creating in one transaction makes concurrent requests on a fresh install wait for each other,
+	// so only one of them can become the first user
+	err = r.controller.DB().Transaction(func(tx *gorm.DB) error {
+		var users int64
+		if res := tx.Model(&model.User{}).Count(&users); res.Error != nil {
+			return res.Error
+		}
+
+		if users == 0 {
+			// the first user sets everything up
+			admin := model.RoleAdmin
+			role = &admin
+		} else {
+			if auth.ForContext(ctx) == nil {
+				return unauthenticated("only the first user can be created without being logged in")
+			}
+			if err := requireRole(ctx, model.RoleAdmin); err != nil {
+				return err
+			}
+		}
+
+		if role == nil {
+			viewer := model.RoleViewer
+			role = &viewer
+		}
+		user.Role = *role
+
+		var taken int64
+		if res := tx.Model(&model.User{}).Where("username = ?", username).Count(&taken); res.Error != nil {
+			return res.Error
+		}
+		if taken > 0 {
+			return fieldConflict("username", "%s is already taken", username)
+		}
+
+		return tx.Create(user).Error
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return user, nil
+}
+
+func (r *mutationResolver) SetUserRole(ctx context.Context, id uint64, role model.Role) (*model.User, error) {
+	var user model.User
+	if err := findByID(r.controller.DB(), &user, "user", id); err != nil {
+		return nil, err
+	}
+
+	if user.Role == model.RoleAdmin && role != model.RoleAdmin {
+		if err := r.keepAnAdmin(); err != nil {
+			return nil, err
+		}
+	}
+
+	user.Role = role
+	res := r.controller.DB().Model(&user).Update("role", role)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &user, nil
+}
+
+func (r *mutationResolver) DeleteUser(ctx context.Context, id uint64) (bool, error) {
+	var user model.User
+	if err := findByID(r.controller.DB(), &user, "user", id); err != nil {
+		return false, err
+	}
+
+	if user.Role == model.RoleAdmin {
+		if err := r.keepAnAdmin(); err != nil {
+			return false, err
+		}
+	}
+
+	err := r.controller.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", id).Delete(&model.Session{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", id).Delete(&model.APIKey{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&user).Error
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) ChangePassword(ctx context.Context, oldPassword string, newPassword string) (bool, error) {
+	identity, err := identityOf(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if auth.CheckPassword(identity.User.PasswordHash, oldPassword) != nil {
+		return false, fieldsError(ErrorCodeValidation, []FieldError{{Field: "oldPassword", Message: "is wrong"}})
+	}
+
+	v := &validation{}
+	v.check(len(newPassword) >= auth.MinPasswordLength, "newPassword", "must have at least %d characters", auth.MinPasswordLength)
+	if err = v.err(); err != nil {
+		return false, err
+	}
+
+	hash, err := auth.HashPassword(newPassword)
+	if err != nil {
+		return false, err
+	}
+
+	// every other session ends, so a stolen session does not outlive the password
+	err = r.controller.DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&identity.User).Update("password_hash", hash).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ? AND id <> ?", identity.User.ID, identity.SessionID).Delete(&model.Session{}).Error
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, name string, role *model.Role) (*model.NewAPIKey, error) {
+	identity, err := identityOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if role == nil {
+		role = &identity.Role
+	}
+
+	v := &validation{}
+	v.notEmpty("name", name)
+	v.check(identity.Role.Includes(*role), "role", "a key can not get more than the role %s of the request", identity.Role)
+	if err = v.err(); err != nil {
+		return nil, err
+	}
+
+	key, err := auth.NewToken(auth.APIKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey := &model.APIKey{
+		UserID:  identity.User.ID,
+		Name:    name,
+		Role:    *role,
+		Prefix:  key[:len(auth.APIKeyPrefix)+8],
+		KeyHash: auth.HashToken(key),
+	}
+
+	res := r.controller.DB().Create(apiKey)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &model.NewAPIKey{
+		Key:    key,
+		APIKey: apiKey,
+	}, nil
+}
+
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, id uint64) (bool, error) {
+	identity, err := identityOf(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	res := r.controller.DB().Where("user_id = ?", identity.User.ID).Delete(&model.APIKey{}, id)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, notFound("API key %d not found", id)
+	}
+
+	return true, nil
+}
+
 func (r *mutationResolver) CreatePlantTemplate(ctx context.Context, input model.PlantTemplateInput) (*model.PlantTemplate, error) {
-	template := &model.PlantTemplate{
-		Name:           input.Name,
-		WaterThreshold: input.WaterThreshold,
+	template := &model.PlantTemplate{}
+	if err := templateFromInput(input, template); err != nil {
+		return nil, err
+	}
+
+	res := r.controller.DB().Create(template)
+	if res.Error != nil {
+		return nil, dbError(res.Error)
 	}
 
-	r.controller.DB().Create(template)
 	return template, nil
 }
 
 func (r *mutationResolver) UpdatePlantTemplate(ctx context.Context, id uint64, input model.PlantTemplateInput) (*model.PlantTemplate, error) {
-	template := &model.PlantTemplate{
-		ID:             id,
-		Name:           input.Name,
-		WaterThreshold: input.WaterThreshold,
+	template := &model.PlantTemplate{}
+	if err := findByID(r.controller.DB(), template, "template", id); err != nil {
+		return nil, err
+	}
+
+	if err := templateFromInput(input, template); err != nil {
+		return nil, err
+	}
+
+	res := r.controller.DB().Save(template)
+	if res.Error != nil {
+		return nil, dbError(res.Error)
 	}
 
-	r.controller.DB().Save(template)
 	return template, nil
 }
 
 func (r *mutationResolver) DeletePlantTemplate(ctx context.Context, ids []*uint64) ([]*uint64, error) {
-	res := r.controller.DB().Delete(&model.PlantTemplate{}, ids)
+	for _, id := range ids {
+		if id == nil {
+			return nil, validationError("template id must not be null")
+		}
+
+		var template model.PlantTemplate
+		if err := findByID(r.controller.DB(), &template, "template", *id); err != nil {
+			return nil, err
+		}
+
+		var plants int64
+		res := r.controller.DB().Model(&model.Plant{}).Where("template_id = ?", *id).Count(&plants)
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		if plants > 0 {
+			return nil, conflict("template %d is used by %d plants", *id, plants)
+		}
+	}
 
+	res := r.controller.DB().Delete(&model.PlantTemplate{}, ids)
 	if res.Error != nil {
 		return nil, res.Error
 	}
@@ -42,72 +338,461 @@ func (r *mutationResolver) DeletePlantTemplate(ctx context.Context, ids []*uint6
 }
 
 func (r *mutationResolver) CreatePlant(ctx context.Context, stationID uint64, input model.PlantInput) (*model.Plant, error) {
-	var template model.PlantTemplate
-	r.controller.DB().First(&template, input.TemplateID)
+	plant := &model.Plant{}
+	if err := plantFromInput(r.controller, stationID, input, plant); err != nil {
+		return nil, err
+	}
 
-	plant := &model.Plant{
-		StationID: stationID,
-		Name:      input.Name,
-		Active:    input.Active,
-		Port:      input.Port,
-		Template:  template,
+	res := r.controller.DB().Create(plant)
+	if res.Error != nil {
+		return nil, dbError(res.Error)
 	}
 
-	r.controller.DB().Create(plant)
+	r.controller.PlantChanged(plant)
 	return plant, nil
 }
 
 func (r *mutationResolver) UpdatePlant(ctx context.Context, id uint64, stationID uint64, input model.PlantInput) (*model.Plant, error) {
-	var template model.PlantTemplate
-	r.controller.DB().First(&template, input.TemplateID)
+	plant := &model.Plant{}
+	if err := findByID(r.controller.DB(), plant, "plant", id); err != nil {
+		return nil, err
+	}
 
-	plant := &model.Plant{
-		ID:        id,
-		StationID: stationID,
-		Name:      input.Name,
-		Active:    input.Active,
-		Port:      input.Port,
-		Template:  template,
+	if err := plantFromInput(r.controller, stationID, input, plant); err != nil {
+		return nil, err
 	}
 
-	r.controller.DB().Save(plant)
+	res := r.controller.DB().Save(plant)
+	if res.Error != nil {
+		return nil, dbError(res.Error)
+	}
+
+	r.controller.PlantChanged(plant)
 	return plant, nil
 }
 
 func (r *mutationResolver) DeletePlant(ctx context.Context, id uint64) (bool, error) {
-	r.controller.DB().Delete(&model.Plant{}, id)
+	err := r.controller.DB().Transaction(func(tx *gorm.DB) error {
+		res := tx.Delete(&model.Plant{}, id)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return notFound("plant %d not found", id)
+		}
+
+		return tx.Where("plant_id = ?", id).Delete(&model.WateringSchedule{}).Error
+	})
+	if err != nil {
+		return false, err
+	}
+
+	r.controller.PlantDeleted(id)
 	return true, nil
 }
 
+func (r *mutationResolver) WaterPlant(ctx context.Context, plantID uint64, seconds int) (*model.WateringResult, error) {
+	v := &validation{}
+	v.check(seconds > 0, "seconds", "must be greater than 0")
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	return r.controller.WaterPlant(ctx, plantID, time.Duration(seconds)*time.Second, nil)
+}
+
+func (r *mutationResolver) WaterPlantUntil(ctx context.Context, plantID uint64, moisture float64, timeout int) (*model.WateringResult, error) {
+	v := &validation{}
+	v.percentage("moisture", moisture)
+	v.check(timeout > 0, "timeout", "must be greater than 0")
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	return r.controller.WaterPlant(ctx, plantID, time.Duration(timeout)*time.Second, &moisture)
+}
+
 func (r *mutationResolver) UpdateStation(ctx context.Context, id uint64, input model.StationInput) (*model.Station, error) {
 	var station model.Station
-	r.controller.DB().First(&station, id)
+	if err := findByID(r.controller.DB(), &station, "station", id); err != nil {
+		return nil, err
+	}
+
+	v := &validation{}
+	v.notEmpty("input.name", input.Name)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
 
 	station.Name = input.Name
-	r.controller.DB().Save(&station)
+
+	res := r.controller.DB().Save(&station)
+	if res.Error != nil {
+		return nil, dbError(res.Error)
+	}
 
 	return &station, nil
 }
 
-func (r *mutationResolver) MoistureFakeValue(ctx context.Context, port string, value float64) (bool, error) {
-	r.controller.SetMoistureFakeValue(port, value)
+func (r *mutationResolver) UpdateStationSettings(ctx context.Context, input []*model.StationSettingsInput) ([]*model.StationSettings, error) {
+	settings := sensors.Settings{
+		Stations: make([]sensors.StationSettings, len(input)),
+	}
+
+	v := &validation{}
+	stationIDs := make(map[uint64]bool)
+	for i, stationInput := range input {
+		path := field("input", i)
+		v.check(!stationIDs[stationInput.StationID], field(path, "stationID"), "station %d is configured more than once", stationInput.StationID)
+		stationIDs[stationInput.StationID] = true
+
+		settings.Stations[i] = stationSettingsFromInput(v, path, stationInput)
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	updated, err := r.controller.UpdateStationSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	stations := make([]*model.StationSettings, len(updated.Stations))
+	for i, stationSettings := range updated.Stations {
+		stations[i] = stationSettingsModel(stationSettings)
+	}
+
+	return stations, nil
+}
+
+func (r *mutationResolver) CreateSchedule(ctx context.Context, input model.WateringScheduleInput) (*model.WateringSchedule, error) {
+	schedule := &model.WateringSchedule{}
+	if err := scheduleFromInput(r.controller, input, schedule); err != nil {
+		return nil, err
+	}
+
+	res := r.controller.DB().Create(schedule)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return schedule, nil
+}
+
+func (r *mutationResolver) UpdateSchedule(ctx context.Context, id uint64, input model.WateringScheduleInput) (*model.WateringSchedule, error) {
+	var schedule model.WateringSchedule
+	if err := findByID(r.controller.DB(), &schedule, "schedule", id); err != nil {
+		return nil, err
+	}
+
+	if err := scheduleFromInput(r.controller, input, &schedule); err != nil {
+		return nil, err
+	}
+
+	res := r.controller.DB().Save(&schedule)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &schedule, nil
+}
+
+func (r *mutationResolver) DeleteSchedule(ctx context.Context, id uint64) (bool, error) {
+	res := r.controller.DB().Delete(&model.WateringSchedule{}, id)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, notFound("schedule %d not found", id)
+	}
+
 	return true, nil
 }
 
-func (r *mutationResolver) WaterFakeValue(ctx context.Context, value float64) (bool, error) {
-	r.controller.SetWaterLevelFakeValue(value)
+func (r *mutationResolver) AcknowledgeAlert(ctx context.Context, id uint64) (*model.Alert, error) {
+	identity, err := identityOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.controller.AcknowledgeAlert(id, identity.User.Username)
+}
+
+func (r *mutationResolver) CaptureCalibration(ctx context.Context, stationID uint64, port string, target model.CalibrationTarget) (*model.PortCalibration, error) {
+	calibration, err := r.controller.CaptureCalibration(stationID, port, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return portCalibration(stationID, port, calibration), nil
+}
+
+func (r *mutationResolver) SetCalibrationCurve(ctx context.Context, stationID uint64, port string, points []*model.CalibrationCurvePointInput) (*model.PortCalibration, error) {
+	v := &validation{}
+	curve := make([]sensors.CalibrationPoint, len(points))
+	for i, p := range points {
+		v.between(field("points", i, "raw"), p.Raw, 0, math.MaxUint16)
+		v.percentage(field("points", i, "percentage"), p.Percentage)
+
+		curve[i] = sensors.CalibrationPoint{
+			Raw:        uint16(p.Raw),
+			Percentage: p.Percentage,
+		}
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	calibration, err := r.controller.SetCalibrationCurve(stationID, port, curve)
+	if err != nil {
+		return nil, err
+	}
+
+	return portCalibration(stationID, port, calibration), nil
+}
+
+func (r *mutationResolver) MoistureFakeValue(ctx context.Context, stationID uint64, port string, value float64) (bool, error) {
+	v := &validation{}
+	v.percentage("value", value)
+	if err := v.err(); err != nil {
+		return false, err
+	}
+
+	if err := r.controller.SetMoistureFakeValue(stationID, port, value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) WaterFakeValue(ctx context.Context, stationID uint64, value float64) (bool, error) {
+	v := &validation{}
+	v.percentage("value", value)
+	if err := v.err(); err != nil {
+		return false, err
+	}
+
+	if err := r.controller.SetWaterLevelFakeValue(stationID, value); err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
+func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
+	identity, err := identityOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity.User, nil
+}
+
+func (r *queryResolver) Users(ctx context.Context) ([]*model.User, error) {
+	var users []*model.User
+	res := r.controller.DB().Order("username").Find(&users)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return users, nil
+}
+
+func (r *queryResolver) APIKeys(ctx context.Context) ([]*model.APIKey, error) {
+	identity, err := identityOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*model.APIKey
+	res := r.controller.DB().Where("user_id = ?", identity.User.ID).Order("created_at").Find(&keys)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return keys, nil
+}
+
+func (r *queryResolver) Alarms(ctx context.Context, stationID *uint64, offset *int, limit *int) (*model.AlarmPage, error) {
+	query := r.controller.DB().Model(&model.Alarm{})
+	if stationID != nil {
+		query = query.Where("station_id = ?", *stationID)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	res := query.Count(&total)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	o, l := pagination(offset, limit)
+
+	var alarms []*model.Alarm
+	res = query.Order("created_at DESC").Offset(o).Limit(l).Find(&alarms)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &model.AlarmPage{
+		Alarms:     alarms,
+		TotalCount: int(total),
+		HasMore:    o+len(alarms) < int(total),
+	}, nil
+}
+
+func (r *queryResolver) Alerts(ctx context.Context, stationID *uint64, active *bool, offset *int, limit *int) (*model.AlertPage, error) {
+	query := r.controller.DB().Model(&model.Alert{})
+	if stationID != nil {
+		query = query.Where("station_id = ?", *stationID)
+	}
+	if active != nil {
+		if *active {
+			query = query.Where("acknowledged_at IS NULL AND resolved_at IS NULL")
+		} else {
+			query = query.Where("acknowledged_at IS NOT NULL OR resolved_at IS NOT NULL")
+		}
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	res := query.Count(&total)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	o, l := pagination(offset, limit)
+
+	alerts := []*model.Alert{}
+	res = query.Order("last_raised_at DESC").Offset(o).Limit(l).Find(&alerts)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &model.AlertPage{
+		Alerts:     alerts,
+		TotalCount: int(total),
+		HasMore:    o+len(alerts) < int(total),
+	}, nil
+}
+
+func (r *queryResolver) Calibration(ctx context.Context, stationID uint64, port string) (*model.PortCalibration, error) {
+	calibration, err := r.controller.Calibration(stationID, port)
+	if err != nil {
+		return nil, err
+	}
+
+	return portCalibration(stationID, port, calibration), nil
+}
+
+func (r *queryResolver) CalibrationPreview(ctx context.Context, stationID uint64, port string) (*model.CalibrationPreview, error) {
+	raw, percentage, err := r.controller.ReadRawMoisture(stationID, port)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CalibrationPreview{
+		StationID:  stationID,
+		Port:       port,
+		Raw:        int(raw),
+		Percentage: percentage,
+	}, nil
+}
+
 func (r *queryResolver) Plant(ctx context.Context, id uint64) (*model.Plant, error) {
 	var plant model.Plant
-	r.controller.DB().Preload("Template").First(&plant, id)
+	if err := findByID(r.controller.DB().Preload("Template"), &plant, "plant", id); err != nil {
+		return nil, err
+	}
 
 	return &plant, nil
 }
 
-func (r *queryResolver) StationPorts(ctx context.Context) ([]*string, error) {
-	ports := r.controller.PossibleStationPorts()
+func (r *queryResolver) PlantStatus(ctx context.Context, stationID uint64) ([]*model.PlantStatus, error) {
+	return r.controller.PlantStatus(stationID)
+}
+
+func (r *queryResolver) RawReadings(ctx context.Context, stationID uint64) ([]*model.RawReading, error) {
+	return r.controller.RawReadings(stationID)
+}
+
+func (r *queryResolver) SensorHealth(ctx context.Context, stationID *uint64) ([]*model.SensorHealth, error) {
+	return r.controller.SensorHealth(stationID)
+}
+
+func (r *queryResolver) Readings(ctx context.Context, plantID uint64, from time.Time, to time.Time, resolution int) ([]*model.ReadingBucket, error) {
+	v := &validation{}
+	v.check(resolution > 0, "resolution", "must be greater than 0 seconds")
+	v.check(from.Before(to), "from", "must be before to")
+	v.check(resolution <= 0 || to.Sub(from)/(time.Duration(resolution)*time.Second) <= maxReadingBuckets, "resolution", "must be high enough for at most %d buckets between from and to", maxReadingBuckets)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	var plant model.Plant
+	if err := findByID(r.controller.DB(), &plant, "plant", plantID); err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Bucket  int64
+		Min     float64
+		Max     float64
+		Average float64
+		Count   int
+	}
+
+	res := r.controller.DB().Model(&model.Reading{}).
+		Select("CAST(strftime('%s', timestamp) AS INTEGER) / ? AS bucket, MIN(value) AS min, MAX(value) AS max, AVG(value) AS average, COUNT(*) AS count", resolution).
+		Where("station_id = ? AND sensor_name = ? AND port = ?", plant.StationID, sensors.MoistureSensorName, plant.Port).
+		// readings from before the plant was stored with them can only be told apart by their port
+		Where("plant_id = ? OR plant_id IS NULL", plant.ID).
+		Where("timestamp >= ? AND timestamp < ?", from.UTC(), to.UTC()).
+		Group("bucket").
+		Order("bucket").
+		Scan(&rows)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	size := time.Duration(resolution) * time.Second
+	buckets := make([]*model.ReadingBucket, len(rows))
+	for i, row := range rows {
+		start := time.Unix(row.Bucket*int64(resolution), 0).UTC()
+		buckets[i] = &model.ReadingBucket{
+			From:    start,
+			To:      start.Add(size),
+			Min:     row.Min,
+			Max:     row.Max,
+			Average: row.Average,
+			Count:   row.Count,
+		}
+	}
+
+	return buckets, nil
+}
+
+func (r *queryResolver) Schedules(ctx context.Context, stationID *uint64, plantID *uint64) ([]*model.WateringSchedule, error) {
+	query := r.controller.DB().Model(&model.WateringSchedule{})
+	if stationID != nil {
+		query = query.Where("station_id = ?", *stationID)
+	}
+	if plantID != nil {
+		query = query.Where("plant_id = ?", *plantID)
+	}
+
+	var schedules []*model.WateringSchedule
+	res := query.Order("start").Find(&schedules)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return schedules, nil
+}
+
+func (r *queryResolver) StationPorts(ctx context.Context, stationID uint64) ([]*string, error) {
+	ports, err := r.controller.PossibleStationPorts(stationID)
+	if err != nil {
+		return nil, err
+	}
 	ptrs := make([]*string, len(ports))
 
 	for i, port := range ports {
@@ -118,18 +803,86 @@ func (r *queryResolver) StationPorts(ctx context.Context) ([]*string, error) {
 	return ptrs, nil
 }
 
+func (r *queryResolver) StationSettings(ctx context.Context) ([]*model.StationSettings, error) {
+	settings := r.controller.StationSettings()
+
+	stations := make([]*model.StationSettings, len(settings.Stations))
+	for i, stationSettings := range settings.Stations {
+		stations[i] = stationSettingsModel(stationSettings)
+	}
+
+	return stations, nil
+}
+
 func (r *queryResolver) Stations(ctx context.Context) ([]*model.Station, error) {
 	var stations []*model.Station
-	r.controller.DB().Preload("Plants.Template").Preload(clause.Associations).Find(&stations)
+	res := r.controller.DB().Preload("Plants.Template").Preload(clause.Associations).Find(&stations)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
 	return stations, nil
 }
 
+func (r *queryResolver) SubscriptionMetrics(ctx context.Context) ([]*model.SubscriptionMetrics, error) {
+	metrics := r.controller.SubscriptionMetrics()
+
+	result := make([]*model.SubscriptionMetrics, len(metrics))
+	for i, m := range metrics {
+		result[i] = &model.SubscriptionMetrics{
+			Topic:       m.Topic,
+			Subscribers: m.Subscribers,
+			Published:   int(m.Published),
+			Delivered:   int(m.Delivered),
+			Dropped:     int(m.Dropped),
+			Coalesced:   int(m.Coalesced),
+		}
+	}
+
+	return result, nil
+}
+
 func (r *queryResolver) Templates(ctx context.Context) ([]*model.PlantTemplate, error) {
 	var templates []*model.PlantTemplate
-	r.controller.DB().Find(&templates)
+	res := r.controller.DB().Find(&templates)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
 	return templates, nil
 }
 
+func (r *queryResolver) WateringEvents(ctx context.Context, plantID *uint64, stationID *uint64, offset *int, limit *int) (*model.WateringEventPage, error) {
+	query := r.controller.DB().Model(&model.WateringEvent{})
+	if plantID != nil {
+		query = query.Where("plant_id = ?", *plantID)
+	}
+	if stationID != nil {
+		query = query.Where("station_id = ?", *stationID)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	res := query.Count(&total)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	o, l := pagination(offset, limit)
+
+	var events []*model.WateringEvent
+	res = query.Order("started_at DESC").Offset(o).Limit(l).Find(&events)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &model.WateringEventPage{
+		Events:     events,
+		TotalCount: int(total),
+		HasMore:    o+len(events) < int(total),
+	}, nil
+}
+
 func (r *queryResolver) Version(ctx context.Context) (string, error) {
 	return r.version, nil
 }
@@ -138,6 +891,18 @@ func (r *subscriptionResolver) Stations(ctx context.Context) (<-chan *model.Stat
 	return r.controller.StationChannel(ctx), nil
 }
 
+func (r *subscriptionResolver) WateringEvents(ctx context.Context, stationID *uint64) (<-chan *model.WateringEvent, error) {
+	return r.controller.WateringEventChannel(ctx, stationID), nil
+}
+
+func (r *subscriptionResolver) RawReadings(ctx context.Context, stationID uint64) (<-chan *model.RawReading, error) {
+	return r.controller.RawReadingChannel(ctx, stationID)
+}
+
+func (r *subscriptionResolver) PlantStatus(ctx context.Context, stationID uint64) (<-chan *model.PlantStatus, error) {
+	return r.controller.PlantStatusChannel(ctx, stationID)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
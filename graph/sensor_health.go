@@ -0,0 +1,174 @@
+package graph
+
+import (
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"log"
+	"sort"
+	"time"
+)
+
+func sensorKey(sensorName string, port string) string {
+	return sensorName + "/" + port
+}
+
+// updateHealth records a read in the health of its sensor and reacts to faults which appeared or went away.
+// It returns false for failed reads, their data must not be used.
+func (s *station) updateHealth(data sensors.SensorData) bool {
+	now := s.clock.Now()
+
+	s.healthMutex.Lock()
+	key := sensorKey(data.SensorName, data.Port.Port)
+	health, ok := s.health[key]
+	if !ok {
+		health = sensors.NewHealth(data.SensorName, data.Port.Port)
+		s.health[key] = health
+	}
+
+	var changed bool
+	if data.Err != nil {
+		changed = health.Failure(now, data.Err, s.settings.MaxSensorFailures)
+	} else {
+		// the water level legitimately stays the same for days while nothing is watered, only moisture sensors get stuck.
+		// Fake moisture sensors without simulation deliver the same value until it is set, they are never stuck.
+		stuckAfter := time.Duration(0)
+		if data.SensorName == sensors.MoistureSensorName && s.moistureFakes[data.Port.Port] == nil {
+			stuckAfter = time.Duration(s.settings.StuckSensorMinutes) * time.Minute
+		}
+		changed = health.Success(now, data.Raw, stuckAfter)
+	}
+	snapshot := *health
+	s.healthMutex.Unlock()
+
+	if changed {
+		s.sensorFaultChanged(&snapshot)
+	}
+
+	return data.Err == nil
+}
+
+// sensorFaultChanged stops the waterings which depend on a sensor which just became faulted.
+// Waterings for a fixed time do not need the moisture, they go on. The alarm is raised by checkAlertConditions.
+func (s *station) sensorFaultChanged(health *sensors.Health) {
+	name := health.SensorName
+	if health.Port != "" {
+		name = fmt.Sprintf("%s sensor of port %s", health.SensorName, health.Port)
+	}
+
+	if !health.Faulted() {
+		log.Println("Station", s.id, name, "is healthy again")
+		return
+	}
+
+	log.Println("Station", s.id, name, "is faulted,", health.Message())
+
+	switch health.SensorName {
+	case sensors.WaterLevelSensorName:
+		s.stopWatering(model.WateringStopReasonSensorFault)
+
+	case sensors.MoistureSensorName:
+		state, ok := s.plantStates[health.Port]
+		if !ok {
+			return
+		}
+
+		if state.active() && (state.timed == nil || state.timed.target != nil) {
+			s.finishWatering(state, model.WateringStopReasonSensorFault)
+		}
+		s.logStatus(state, "moisture sensor is faulted, not watering")
+	}
+}
+
+// sensorFaulted reports whether the decisions of the station must not rely on the sensor.
+func (s *station) sensorFaulted(sensorName string, port string) bool {
+	s.healthMutex.RLock()
+	defer s.healthMutex.RUnlock()
+
+	health, ok := s.health[sensorKey(sensorName, port)]
+	return ok && health.Faulted()
+}
+
+// SensorHealth returns the health of every sensor of the station, the water level first and then the ports.
+func (s *station) SensorHealth() []*model.SensorHealth {
+	s.healthMutex.RLock()
+	defer s.healthMutex.RUnlock()
+
+	result := make([]*model.SensorHealth, 0, len(s.health))
+	for _, health := range s.health {
+		result = append(result, sensorHealthModel(s.id, health))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Port != result[j].Port {
+			return result[i].Port < result[j].Port
+		}
+		return result[i].SensorName < result[j].SensorName
+	})
+
+	return result
+}
+
+func sensorHealthModel(stationID uint64, health *sensors.Health) *model.SensorHealth {
+	m := &model.SensorHealth{
+		StationID:           stationID,
+		SensorName:          health.SensorName,
+		Port:                health.Port,
+		Healthy:             !health.Faulted(),
+		ConsecutiveFailures: health.ConsecutiveFailures,
+		LastSuccess:         optionalTime(health.LastSuccess),
+		LastFailure:         optionalTime(health.LastFailure),
+		UnchangedSince:      optionalTime(health.UnchangedSince),
+	}
+
+	if health.LastError != "" {
+		lastError := health.LastError
+		m.LastError = &lastError
+	}
+
+	if health.Faulted() {
+		fault := model.SensorFault(health.Fault)
+		message := health.Message()
+		m.Fault = &fault
+		m.Message = &message
+		m.FaultSince = optionalTime(health.FaultSince)
+	}
+
+	return m
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
+
+// SensorHealth returns the health of the sensors of a station, or of all stations if stationID is nil.
+func (c *controller) SensorHealth(stationID *uint64) ([]*model.SensorHealth, error) {
+	if stationID != nil {
+		s, err := c.station(*stationID)
+		if err != nil {
+			return nil, err
+		}
+		return s.SensorHealth(), nil
+	}
+
+	c.stationsMutex.RLock()
+	defer c.stationsMutex.RUnlock()
+
+	ids := make([]uint64, 0, len(c.stations))
+	for id := range c.stations {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	result := []*model.SensorHealth{}
+	for _, id := range ids {
+		result = append(result, c.stations[id].SensorHealth()...)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,392 @@
+package graph
+
+import (
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/actuators"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"log"
+	"os"
+	"reflect"
+	"time"
+)
+
+const settingsPollInterval = 2 * time.Second
+
+// StationSettings returns a copy of the settings all stations currently run with.
+func (c *controller) StationSettings() sensors.Settings {
+	c.settingsMutex.Lock()
+	defer c.settingsMutex.Unlock()
+
+	return copySettings(c.settings)
+}
+
+// UpdateStationSettings replaces the settings of all stations, rebuilds the stations and writes the settings file.
+// Ports without calibration keep the calibration they had before.
+func (c *controller) UpdateStationSettings(settings sensors.Settings) (sensors.Settings, error) {
+	c.settingsMutex.Lock()
+	defer c.settingsMutex.Unlock()
+
+	for i := range settings.Stations {
+		station := &settings.Stations[i]
+		for j := range station.Ports {
+			port := &station.Ports[j]
+			if reflect.DeepEqual(port.Calibration, sensors.Calibration{}) {
+				port.Calibration = c.currentCalibration(station.StationID, port.Port)
+			}
+		}
+	}
+	settings.ApplyDefaults()
+
+	if err := c.applySettings(&settings); err != nil {
+		return sensors.Settings{}, err
+	}
+
+	if err := c.saveSettings(); err != nil {
+		return sensors.Settings{}, err
+	}
+
+	return copySettings(c.settings), nil
+}
+
+// currentCalibration returns the current calibration of a port, or the default one for ports which do not exist yet.
+// The settings mutex has to be held by the caller.
+func (c *controller) currentCalibration(stationID uint64, port string) sensors.Calibration {
+	for _, station := range c.settings.Stations {
+		if station.StationID != stationID {
+			continue
+		}
+
+		for _, p := range station.Ports {
+			if p.Port == port {
+				return p.Calibration
+			}
+		}
+	}
+
+	return sensors.DefaultCalibration
+}
+
+// validateSettings checks the settings and that every GPIO exists and is used only once per board.
+// Real hardware shares one board between all stations, fake and replaying stations have one each.
+func (c *controller) validateSettings(settings *sensors.Settings) error {
+	if err := settings.Validate(); err != nil {
+		return withCode(ErrorCodeValidation, err)
+	}
+
+	used := make(map[string]map[int]string)
+	for _, station := range settings.Stations {
+		board := "rpi"
+		if c.board == nil || station.ReplayFile != "" {
+			board = fmt.Sprintf("station %d", station.StationID)
+		}
+		if used[board] == nil {
+			used[board] = make(map[int]string)
+		}
+
+		if err := useGPIO(used[board], station.PumpGPIO, fmt.Sprintf("pump of station %d", station.StationID)); err != nil {
+			return err
+		}
+
+		for _, port := range station.Ports {
+			if err := useGPIO(used[board], port.ValveGPIO, fmt.Sprintf("valve of station %d port %s", station.StationID, port.Port)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func useGPIO(used map[int]string, gpio int, user string) error {
+	if _, err := actuators.GetGPIO(gpio); err != nil {
+		return validationError("%s: %w", user, err)
+	}
+
+	if other, ok := used[gpio]; ok {
+		return validationError("gpio %d is used by the %s and the %s", gpio, other, user)
+	}
+	used[gpio] = user
+
+	return nil
+}
+
+// buildStations creates the stations of the settings without starting them.
+// Fake boards are kept per station id, so the recorded level changes survive a reload.
+func (c *controller) buildStations(settings *sensors.Settings) (map[uint64]*station, error) {
+	stations := make(map[uint64]*station)
+
+	for _, stationSettings := range settings.Stations {
+		board := c.board
+		if board == nil || stationSettings.ReplayFile != "" {
+			board = c.fakeBoards[stationSettings.StationID]
+			if board == nil {
+				board = actuators.NewFakeBoard(c.clock)
+				c.fakeBoards[stationSettings.StationID] = board
+			}
+		}
+
+		s, err := newStation(c, stationSettings, board)
+		if err != nil {
+			for _, built := range stations {
+				built.close()
+			}
+			return nil, hardwareError("station %d: %w", stationSettings.StationID, err)
+		}
+
+		stations[s.id] = s
+	}
+
+	return stations, nil
+}
+
+// applySettings stops all stations and starts them again with the new settings.
+// If the new stations can not be built the old settings are restored. The settings mutex has to be held by the caller.
+func (c *controller) applySettings(settings *sensors.Settings) error {
+	if err := c.validateSettings(settings); err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(settings, c.settings) {
+		return nil
+	}
+
+	c.stationsMutex.Lock()
+	defer c.stationsMutex.Unlock()
+
+	old := c.stations
+	for _, s := range old {
+		s.Stop()
+	}
+
+	stations, err := c.buildStations(settings)
+	if err != nil {
+		log.Println("could not apply station settings, restoring the previous ones:", err)
+
+		restored, restoreErr := c.buildStations(c.settings)
+		if restoreErr != nil {
+			log.Println("could not restore the previous station settings:", restoreErr)
+			restored = make(map[uint64]*station)
+		}
+
+		c.startStations(restored, old)
+		return err
+	}
+
+	c.settings = settings
+	c.startStations(stations, old)
+	log.Println("station settings applied")
+
+	return nil
+}
+
+// startStations carries the state of the stopped stations over to the new ones and starts them.
+func (c *controller) startStations(stations map[uint64]*station, old map[uint64]*station) {
+	for id, s := range stations {
+		if previous, ok := old[id]; ok {
+			s.inherit(previous)
+		}
+		s.Start()
+	}
+
+	c.stations = stations
+}
+
+// saveSettings writes the settings file and remembers its modification time, so the watcher does not reload it.
+// The settings mutex has to be held by the caller.
+func (c *controller) saveSettings() error {
+	if err := sensors.SaveSettings(c.settingsPath, c.settings); err != nil {
+		return err
+	}
+
+	if info, err := os.Stat(c.settingsPath); err == nil {
+		c.settingsModTime = info.ModTime()
+	}
+	return nil
+}
+
+// watchSettings reloads the settings file whenever it was changed by someone else.
+func (c *controller) watchSettings() {
+	ticker := time.NewTicker(settingsPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		info, err := os.Stat(c.settingsPath)
+		if err != nil {
+			continue
+		}
+
+		c.settingsMutex.Lock()
+		if !info.ModTime().Equal(c.settingsModTime) {
+			c.settingsModTime = info.ModTime()
+			c.reloadSettings()
+		}
+		c.settingsMutex.Unlock()
+	}
+}
+
+// reloadSettings reads and applies the settings file. The settings mutex has to be held by the caller.
+func (c *controller) reloadSettings() {
+	log.Println("station settings changed, reloading", c.settingsPath)
+
+	settings, err := sensors.LoadSettings(c.settingsPath)
+	if err != nil {
+		log.Println("could not load station settings:", err)
+		return
+	}
+
+	if err = c.applySettings(settings); err != nil {
+		log.Println("could not apply station settings:", err)
+	}
+}
+
+// copySettings copies the settings deep enough that the copy can be changed without affecting the stations.
+func copySettings(settings *sensors.Settings) sensors.Settings {
+	stations := make([]sensors.StationSettings, len(settings.Stations))
+	for i, station := range settings.Stations {
+		ports := make([]sensors.PortSetting, len(station.Ports))
+		for j, port := range station.Ports {
+			points := make([]sensors.CalibrationPoint, len(port.Calibration.Points))
+			copy(points, port.Calibration.Points)
+			port.Calibration.Points = points
+			ports[j] = port
+		}
+		station.Ports = ports
+		stations[i] = station
+	}
+
+	return sensors.Settings{
+		Stations: stations,
+	}
+}
+
+func stationSettingsModel(settings sensors.StationSettings) *model.StationSettings {
+	ports := make([]*model.PortSettings, len(settings.Ports))
+	for i, p := range settings.Ports {
+		ports[i] = &model.PortSettings{
+			Port:            p.Port,
+			MoistureChannel: int(p.MoistureChannel),
+			ValveGPIO:       p.ValveGPIO,
+			MaxOpenSeconds:  p.MaxOpenSeconds,
+			CooldownSeconds: p.CooldownSeconds,
+			Calibration:     portCalibration(settings.StationID, p.Port, p.Calibration),
+		}
+	}
+
+	m := &model.StationSettings{
+		StationID:             settings.StationID,
+		Name:                  settings.Name,
+		GroveBus:              settings.GroveBus,
+		WaterLevelHighAddress: int(settings.WaterLevelHighAddress),
+		WaterLevelLowAddress:  int(settings.WaterLevelLowAddress),
+		MoistureAddress:       int(settings.MoistureAddress),
+		PumpGPIO:              settings.PumpGPIO,
+		MaxDailyPumpSeconds:   settings.MaxDailyPumpSeconds,
+		MinWaterLevel:         settings.MinWaterLevel,
+		ReservoirAlertLevel:   settings.ReservoirAlertLevel,
+		MaxSensorFailures:     settings.MaxSensorFailures,
+		StuckSensorMinutes:    settings.StuckSensorMinutes,
+		Ports:                 ports,
+		Simulation: &model.SimulationSettings{
+			Enabled:         settings.Simulation.Enabled,
+			EvaporationRate: settings.Simulation.EvaporationRate,
+			ValveFlowRate:   settings.Simulation.ValveFlowRate,
+			PumpDrainRate:   settings.Simulation.PumpDrainRate,
+		},
+	}
+
+	if settings.RecordFile != "" {
+		m.RecordFile = &settings.RecordFile
+	}
+	if settings.ReplayFile != "" {
+		m.ReplayFile = &settings.ReplayFile
+	}
+
+	return m
+}
+
+// stationSettingsFromInput converts the input and adds its field errors to v, limits and rates left out get their defaults.
+func stationSettingsFromInput(v *validation, path string, input *model.StationSettingsInput) sensors.StationSettings {
+	v.check(input.StationID > 0, field(path, "stationID"), "must be greater than 0")
+	v.between(field(path, "waterLevelHighAddress"), input.WaterLevelHighAddress, 0, 0x7f)
+	v.between(field(path, "waterLevelLowAddress"), input.WaterLevelLowAddress, 0, 0x7f)
+	v.between(field(path, "moistureAddress"), input.MoistureAddress, 0, 0x7f)
+
+	settings := sensors.NewStationSettings()
+	settings.StationID = input.StationID
+	settings.Name = input.Name
+	settings.GroveBus = input.GroveBus
+	settings.WaterLevelHighAddress = uint16(input.WaterLevelHighAddress)
+	settings.WaterLevelLowAddress = uint16(input.WaterLevelLowAddress)
+	settings.MoistureAddress = uint16(input.MoistureAddress)
+	settings.PumpGPIO = input.PumpGPIO
+	settings.Ports = make([]sensors.PortSetting, len(input.Ports))
+
+	if input.MaxDailyPumpSeconds != nil {
+		settings.MaxDailyPumpSeconds = *input.MaxDailyPumpSeconds
+		v.check(settings.MaxDailyPumpSeconds > 0, field(path, "maxDailyPumpSeconds"), "must be greater than 0")
+	}
+	if input.MinWaterLevel != nil {
+		settings.MinWaterLevel = *input.MinWaterLevel
+		v.percentage(field(path, "minWaterLevel"), settings.MinWaterLevel)
+	}
+	if input.ReservoirAlertLevel != nil {
+		settings.ReservoirAlertLevel = *input.ReservoirAlertLevel
+		v.percentage(field(path, "reservoirAlertLevel"), settings.ReservoirAlertLevel)
+	}
+	if input.MaxSensorFailures != nil {
+		settings.MaxSensorFailures = *input.MaxSensorFailures
+		v.check(settings.MaxSensorFailures > 0, field(path, "maxSensorFailures"), "must be greater than 0")
+	}
+	if input.StuckSensorMinutes != nil {
+		settings.StuckSensorMinutes = *input.StuckSensorMinutes
+		v.check(settings.StuckSensorMinutes > 0, field(path, "stuckSensorMinutes"), "must be greater than 0")
+	}
+	if input.RecordFile != nil {
+		settings.RecordFile = *input.RecordFile
+	}
+	if input.ReplayFile != nil {
+		settings.ReplayFile = *input.ReplayFile
+	}
+
+	if sim := input.Simulation; sim != nil {
+		settings.Simulation.Enabled = sim.Enabled
+		if sim.EvaporationRate != nil {
+			settings.Simulation.EvaporationRate = *sim.EvaporationRate
+			v.notNegative(field(path, "simulation", "evaporationRate"), settings.Simulation.EvaporationRate)
+		}
+		if sim.ValveFlowRate != nil {
+			settings.Simulation.ValveFlowRate = *sim.ValveFlowRate
+			v.notNegative(field(path, "simulation", "valveFlowRate"), settings.Simulation.ValveFlowRate)
+		}
+		if sim.PumpDrainRate != nil {
+			settings.Simulation.PumpDrainRate = *sim.PumpDrainRate
+			v.notNegative(field(path, "simulation", "pumpDrainRate"), settings.Simulation.PumpDrainRate)
+		}
+	}
+
+	ports := make(map[string]bool)
+	for i, p := range input.Ports {
+		portPath := field(path, "ports", i)
+		if v.notEmpty(field(portPath, "port"), p.Port) {
+			v.check(!ports[p.Port], field(portPath, "port"), "port %s is configured more than once", p.Port)
+			ports[p.Port] = true
+		}
+		v.between(field(portPath, "moistureChannel"), p.MoistureChannel, 0, 7)
+
+		settings.Ports[i] = sensors.NewPortSetting()
+		settings.Ports[i].Port = p.Port
+		settings.Ports[i].MoistureChannel = byte(p.MoistureChannel)
+		settings.Ports[i].ValveGPIO = p.ValveGPIO
+		if p.MaxOpenSeconds != nil {
+			settings.Ports[i].MaxOpenSeconds = *p.MaxOpenSeconds
+			v.check(*p.MaxOpenSeconds > 0, field(portPath, "maxOpenSeconds"), "must be greater than 0")
+		}
+		if p.CooldownSeconds != nil {
+			settings.Ports[i].CooldownSeconds = *p.CooldownSeconds
+			v.notNegative(field(portPath, "cooldownSeconds"), float64(*p.CooldownSeconds))
+		}
+	}
+
+	return settings
+}
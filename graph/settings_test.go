@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"testing"
+)
+
+func TestStationSettingsInputReportsEveryInvalidField(t *testing.T) {
+	zero := 0
+	negative := -1.0
+	tooHigh := 101.0
+	input := &model.StationSettingsInput{
+		StationID:             0,
+		WaterLevelHighAddress: 0x80,
+		MaxDailyPumpSeconds:   &zero,
+		MinWaterLevel:         &tooHigh,
+		Simulation:            &model.SimulationSettingsInput{ValveFlowRate: &negative},
+		Ports: []*model.PortSettingsInput{
+			{Port: "A", MoistureChannel: 8, MaxOpenSeconds: &zero},
+			{Port: "A"},
+			{Port: " "},
+		},
+	}
+
+	v := &validation{}
+	stationSettingsFromInput(v, "input.0", input)
+
+	var e *Error
+	if err := v.err(); !errors.As(err, &e) || e.Code != ErrorCodeValidation {
+		t.Fatalf("got %v, want a validation error", err)
+	}
+
+	want := []string{
+		"input.0.stationID",
+		"input.0.waterLevelHighAddress",
+		"input.0.maxDailyPumpSeconds",
+		"input.0.minWaterLevel",
+		"input.0.simulation.valveFlowRate",
+		"input.0.ports.0.moistureChannel",
+		"input.0.ports.0.maxOpenSeconds",
+		"input.0.ports.1.port",
+		"input.0.ports.2.port",
+	}
+	if len(e.Fields) != len(want) {
+		t.Fatalf("got field errors %+v, want errors of %v", e.Fields, want)
+	}
+	for i, f := range e.Fields {
+		if f.Field != want[i] {
+			t.Errorf("got an error of %s: %s, want one of %s", f.Field, f.Message, want[i])
+		}
+	}
+}
+
+func TestStationSettingsInputGetsTheDefaults(t *testing.T) {
+	input := &model.StationSettingsInput{
+		StationID: 1,
+		Ports:     []*model.PortSettingsInput{{Port: "A", MoistureChannel: 7, ValveGPIO: 24}},
+	}
+
+	v := &validation{}
+	settings := stationSettingsFromInput(v, "input.0", input)
+	if err := v.err(); err != nil {
+		t.Fatal(err)
+	}
+
+	defaults := sensors.NewStationSettings()
+	if settings.MaxDailyPumpSeconds != defaults.MaxDailyPumpSeconds || settings.MinWaterLevel != defaults.MinWaterLevel {
+		t.Errorf("got limits %d and %g, want the defaults %d and %g", settings.MaxDailyPumpSeconds, settings.MinWaterLevel, defaults.MaxDailyPumpSeconds, defaults.MinWaterLevel)
+	}
+	if port := settings.Ports[0]; port.MaxOpenSeconds != sensors.NewPortSetting().MaxOpenSeconds || port.CooldownSeconds != sensors.DefaultCooldownSeconds {
+		t.Errorf("got port %+v, want the default limits", port)
+	}
+}
+
+func TestInvalidStationSettingsAreNotApplied(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	r := &mutationResolver{&Resolver{controller: tc.controller, clock: tc.clock}}
+
+	station := &model.StationSettingsInput{StationID: 1, Ports: []*model.PortSettingsInput{{Port: "A", ValveGPIO: 24}}}
+	_, err := r.UpdateStationSettings(context.Background(), []*model.StationSettingsInput{station, station})
+
+	var e *Error
+	if !errors.As(err, &e) || e.Code != ErrorCodeValidation || len(e.Fields) != 1 || e.Fields[0].Field != "input.1.stationID" {
+		t.Fatalf("got %v, want a validation error of the station configured twice", err)
+	}
+	if stations := tc.StationSettings().Stations; len(stations) != 1 || stations[0].PumpGPIO != 23 {
+		t.Errorf("got stations %+v, want the settings unchanged", stations)
+	}
+}
@@ -0,0 +1,544 @@
+package graph
+
+import (
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/actuators"
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"github.com/ZamarianPatrick/lazypig-backend/simulation"
+	"log"
+	"math"
+	"math/rand"
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"sort"
+	"sync"
+	"time"
+)
+
+type station struct {
+	id         uint64
+	controller *controller
+	settings   sensors.StationSettings
+
+	bus          i2c.BusCloser
+	board        actuators.Board
+	sensorWorker sensors.Worker
+	pump         *actuators.Relay
+
+	moistureSensors map[string]sensors.CalibratedSensor
+	moistureFakes   map[string]*sensors.MoistureFake
+	waterLevelFake  *sensors.WaterFake
+	simulation      *simulation.Simulation
+	recorder        *sensors.Recorder
+
+	clock    clock.Clock
+	random   *rand.Rand
+	commands chan func()
+	done     chan struct{}
+	stopped  chan struct{}
+
+	rawMutex    sync.RWMutex
+	rawReadings map[string]*model.RawReading
+
+	statusMutex sync.RWMutex
+	plantStatus map[string]*model.PlantStatus
+
+	healthMutex sync.RWMutex
+	health      map[string]*sensors.Health
+
+	// alertConditions tells for each alert condition whether it held at the last check
+	alertConditions map[string]bool
+
+	lastWaterLevel  float64
+	plantStates     map[string]*plantState
+	pumpRuntime     time.Duration
+	pumpRuntimeDay  string
+	lastSafetyCheck time.Time
+
+	lastScheduleMinute time.Time
+}
+
+func newStation(c *controller, settings sensors.StationSettings, board actuators.Board) (*station, error) {
+	s := &station{
+		id:              settings.StationID,
+		controller:      c,
+		settings:        settings,
+		board:           board,
+		moistureSensors: make(map[string]sensors.CalibratedSensor),
+		moistureFakes:   make(map[string]*sensors.MoistureFake),
+		plantStates:     make(map[string]*plantState),
+		rawReadings:     make(map[string]*model.RawReading),
+		plantStatus:     make(map[string]*model.PlantStatus),
+		health:          make(map[string]*sensors.Health),
+		alertConditions: make(map[string]bool),
+		lastWaterLevel:  -1,
+		commands:        make(chan func()),
+		done:            make(chan struct{}),
+		stopped:         make(chan struct{}),
+		clock:           c.clock,
+		random:          rand.New(rand.NewSource(c.seed + int64(settings.StationID))),
+	}
+
+	var records []sensors.Record
+	var recordingStart time.Time
+	if settings.ReplayFile != "" {
+		var err error
+		records, err = sensors.LoadRecording(settings.ReplayFile)
+		if err != nil {
+			return nil, err
+		}
+		recordingStart = sensors.RecordingStart(records)
+
+		s.sensorWorker =
+			sensors.NewWorker(c.clock).
+				Add(sensors.NewReplay(records, recordingStart, sensors.WaterLevelSensorName, sensors.PortSetting{}, c.clock))
+	} else if c.fakeValues {
+		fake := sensors.NewWaterFake(100)
+		s.waterLevelFake = fake.(*sensors.WaterFake)
+		s.sensorWorker =
+			sensors.NewWorker(c.clock).
+				Add(fake)
+	} else {
+		bus, err := i2creg.Open(settings.GroveBus)
+		if err != nil {
+			return nil, err
+		}
+
+		s.bus = bus
+		s.sensorWorker =
+			sensors.NewWorker(c.clock).
+				Add(sensors.NewWaterLevel(bus, settings.WaterLevelHighAddress, settings.WaterLevelLowAddress))
+	}
+
+	s.health[sensorKey(sensors.WaterLevelSensorName, "")] = sensors.NewHealth(sensors.WaterLevelSensorName, "")
+
+	for _, port := range settings.Ports {
+		var sensor sensors.Sensor
+		if settings.ReplayFile != "" {
+			sensor = sensors.NewReplay(records, recordingStart, sensors.MoistureSensorName, port, c.clock)
+		} else if c.fakeValues {
+			sensor = sensors.NewMoistureFake(port)
+			s.moistureFakes[port.Port] = sensor.(*sensors.MoistureFake)
+		} else {
+			sensor = sensors.NewMoisture(s.bus, settings.MoistureAddress, port)
+		}
+
+		s.health[sensorKey(sensors.MoistureSensorName, port.Port)] = sensors.NewHealth(sensors.MoistureSensorName, port.Port)
+		if calibrated, ok := sensor.(sensors.CalibratedSensor); ok {
+			s.moistureSensors[port.Port] = calibrated
+		}
+		s.sensorWorker.Add(sensor)
+	}
+
+	var err error
+	s.pump, err = actuators.NewRelay(board, settings.PumpGPIO)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.pump.Off(); err != nil {
+		return nil, err
+	}
+
+	for _, p := range settings.Ports {
+		valve, err := actuators.NewRelay(board, p.ValveGPIO)
+		if err != nil {
+			return nil, err
+		}
+		if err = valve.Off(); err != nil {
+			return nil, err
+		}
+		s.plantStates[p.Port] = &plantState{
+			port:  p,
+			valve: valve,
+		}
+	}
+
+	if settings.RecordFile != "" {
+		s.recorder, err = sensors.NewRecorder(settings.RecordFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.fakeValues && settings.Simulation.Enabled && settings.ReplayFile == "" {
+		s.simulation, err = simulation.New(settings.Simulation, board, settings.PumpGPIO, s.waterLevelFake)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range settings.Ports {
+			if err = s.simulation.AddPort(p, board, s.moistureFakes[p.Port]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var dbStation model.Station
+	r := c.db.First(&dbStation, s.id)
+	if r.Error != nil {
+		name := settings.Name
+		if name == "" {
+			name = fmt.Sprintf("Station %d", s.id)
+		}
+
+		dbStation = model.Station{
+			ID:   s.id,
+			Name: name,
+		}
+		if r = c.db.Create(&dbStation); r.Error != nil {
+			return nil, r.Error
+		}
+	}
+
+	return s, nil
+}
+
+func (s *station) Start() {
+	s.ReadSensors()
+	s.sensorWorker.Start()
+}
+
+func (s *station) Ports() []string {
+	portNames := make([]string, len(s.settings.Ports))
+
+	for i, p := range s.settings.Ports {
+		portNames[i] = p.Port
+	}
+
+	return portNames
+}
+
+func (s *station) SetMoistureFakeValue(port string, value float64) error {
+	m, ok := s.moistureFakes[port]
+	if !ok {
+		return notFound("station %d has no fake moisture sensor on port %s", s.id, port)
+	}
+
+	m.SetValue(value)
+	return nil
+}
+
+func (s *station) SetWaterLevelFakeValue(value float64) error {
+	if s.waterLevelFake == nil {
+		return notFound("station %d has no fake water level sensor", s.id)
+	}
+
+	actualVal, _ := s.waterLevelFake.ReadValue()
+	for actualVal != value {
+		switch {
+		case math.Abs(actualVal-value) < 1:
+			actualVal = value
+		case actualVal > value:
+			actualVal -= 1
+		default:
+			actualVal += 1
+		}
+		s.waterLevelFake.SetValue(actualVal)
+
+		timeout := time.Duration(s.random.Intn(200) + 100)
+		s.clock.Sleep(time.Millisecond * timeout)
+	}
+
+	return nil
+}
+
+// Stop ends the loop of the station, finishes all its waterings and switches the pump off.
+// It returns once the loop ended, a stopped station can not be started again.
+func (s *station) Stop() {
+	s.sensorWorker.Stop()
+	close(s.done)
+	<-s.stopped
+
+	s.close()
+}
+
+// close releases the bus and the recording of a station which is not running.
+func (s *station) close() {
+	if s.recorder != nil {
+		if err := s.recorder.Close(); err != nil {
+			log.Println("could not close recording", err)
+		}
+	}
+
+	if s.bus != nil {
+		if err := s.bus.Close(); err != nil {
+			log.Println("could not close i2c bus", err)
+		}
+	}
+}
+
+// inherit takes over the state of the stopped station it replaces, so a reload neither resets the daily pump runtime
+// nor the cooldowns of the valves nor the health of the sensors nor the alerts, and fake sensors keep their values.
+func (s *station) inherit(previous *station) {
+	s.pumpRuntime = previous.pumpRuntime
+	s.pumpRuntimeDay = previous.pumpRuntimeDay
+	s.lastScheduleMinute = previous.lastScheduleMinute
+
+	for port, state := range s.plantStates {
+		if old, ok := previous.plantStates[port]; ok {
+			state.closedAt = old.closedAt
+		}
+	}
+
+	for key, health := range s.health {
+		if old, ok := previous.health[key]; ok {
+			*health = *old
+		}
+	}
+
+	for key, held := range previous.alertConditions {
+		s.alertConditions[key] = held
+	}
+
+	for port, fake := range s.moistureFakes {
+		if old, ok := previous.moistureFakes[port]; ok {
+			fake.SetValue(old.Value())
+		}
+	}
+
+	if s.waterLevelFake != nil && previous.waterLevelFake != nil {
+		value, _ := previous.waterLevelFake.ReadValue()
+		s.waterLevelFake.SetValue(value)
+	}
+}
+
+// post runs cmd in the loop of the station, it returns false if the station was stopped.
+func (s *station) post(cmd func()) bool {
+	select {
+	case s.commands <- cmd:
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+func (s *station) ReadSensors() {
+	go func() {
+		defer close(s.stopped)
+
+		var ch chan sensors.SensorData
+		ch = s.sensorWorker.DataChannel()
+
+		// the ticker keeps the safety limits enforced even if no sensor delivers values anymore
+		ticker := s.clock.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for true {
+			select {
+			case data := <-ch:
+				s.handleSensorData(data)
+			case cmd := <-s.commands:
+				cmd()
+			case <-ticker.C():
+				s.simulate()
+			case <-s.done:
+				s.stopWatering(model.WateringStopReasonStopped)
+				if err := s.pump.Off(); err != nil {
+					fmt.Println(err)
+				}
+				return
+			}
+
+			s.checkSafetyLimits()
+			s.checkTimedWaterings()
+			s.runFixedSchedules()
+			s.advanceWaterings()
+
+			if err := s.pump.Set(s.watering()); err != nil {
+				fmt.Println(err)
+			}
+
+			s.checkAlertConditions()
+			s.publishPlantStatus()
+		}
+	}()
+}
+
+// simulate advances the simulation of the fake sensors, with the evaporation rates of the plants currently on the ports.
+func (s *station) simulate() {
+	if s.simulation == nil {
+		return
+	}
+
+	var plants []model.Plant
+	s.controller.db.Where("station_id = ? AND active = ?", s.id, true).Find(&plants)
+
+	rates := make(map[string]float64)
+	for _, plant := range plants {
+		rates[plant.Port] = plant.EvaporationRate
+	}
+	for port := range s.plantStates {
+		s.simulation.SetEvaporationRate(port, rates[port])
+	}
+
+	s.simulation.Step(s.clock.Now())
+}
+
+func (s *station) handleSensorData(data sensors.SensorData) {
+	c := s.controller
+
+	// failed reads are recorded as well, so the faults of the sensors can be replayed
+	if s.recorder != nil {
+		if err := s.recorder.Record(s.clock.Now(), data); err != nil {
+			log.Println("could not record sensor data", err)
+		}
+	}
+
+	if !s.updateHealth(data) {
+		return
+	}
+
+	reading := &model.Reading{
+		StationID:  s.id,
+		SensorName: data.SensorName,
+		Port:       data.Port.Port,
+		Value:      data.Value,
+		Timestamp:  s.clock.Now().UTC(),
+	}
+
+	s.updateRawReading(data)
+
+	switch data.SensorName {
+	case sensors.WaterLevelSensorName:
+		if s.lastWaterLevel < 0 || math.Abs(s.lastWaterLevel-data.Value) > 1 {
+			var station model.Station
+			err := c.db.First(&station, s.id).Error
+			if err == nil {
+				station.WaterLevel = data.Value
+				err = c.db.Save(&station).Error
+			}
+			if err != nil {
+				log.Println("station", s.id, "could not store the water level:", err)
+			} else {
+				c.publishStation(&station)
+			}
+
+			s.lastWaterLevel = data.Value
+		}
+
+	case sensors.MoistureSensorName:
+		state, ok := s.plantStates[data.Port.Port]
+		if !ok {
+			break
+		}
+
+		state.currentMoisture = data.Value
+		state.measured = true
+		s.evaluatePlant(state)
+
+		// the reading belongs to the plant which is active on the port now, so the history of a plant
+		// does not contain the readings of the plants which were on the port before
+		plantID := state.activePlantID
+		reading.PlantID = &plantID
+	}
+
+	if err := c.db.Create(reading).Error; err != nil {
+		log.Println("station", s.id, "could not store the reading of the", data.SensorName, "sensor:", err)
+	}
+}
+
+func (s *station) updateRawReading(data sensors.SensorData) {
+	raw := make([]*model.RawValue, len(data.Raw))
+	for i, r := range data.Raw {
+		raw[i] = &model.RawValue{
+			Name:   r.Name,
+			Values: r.Values,
+		}
+	}
+
+	reading := &model.RawReading{
+		StationID:  s.id,
+		SensorName: data.SensorName,
+		Port:       data.Port.Port,
+		Value:      data.Value,
+		Raw:        raw,
+		Timestamp:  s.clock.Now().UTC(),
+	}
+
+	s.rawMutex.Lock()
+	s.rawReadings[data.SensorName+"/"+data.Port.Port] = reading
+	s.rawMutex.Unlock()
+
+	s.controller.publishRawReading(reading)
+}
+
+// RawReadings returns the latest reading of every sensor of the station.
+func (s *station) RawReadings() []*model.RawReading {
+	s.rawMutex.RLock()
+	defer s.rawMutex.RUnlock()
+
+	keys := make([]string, 0, len(s.rawReadings))
+	for key := range s.rawReadings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	readings := make([]*model.RawReading, len(keys))
+	for i, key := range keys {
+		readings[i] = s.rawReadings[key]
+	}
+
+	return readings
+}
+
+func (s *station) dailyLimitReached() bool {
+	return s.pumpRuntime >= time.Duration(s.settings.MaxDailyPumpSeconds)*time.Second
+}
+
+func (s *station) checkSafetyLimits() {
+	now := s.clock.Now()
+
+	day := now.Format("2006-01-02")
+	if day != s.pumpRuntimeDay {
+		s.pumpRuntimeDay = day
+		s.pumpRuntime = 0
+	}
+
+	if s.pump.IsOn() && !s.lastSafetyCheck.IsZero() {
+		s.pumpRuntime += now.Sub(s.lastSafetyCheck)
+	}
+	s.lastSafetyCheck = now
+
+	for _, state := range s.plantStates {
+		maxOpen := time.Duration(state.port.MaxOpenSeconds) * time.Second
+		if state.valveOpen() && now.Sub(state.phaseSince) >= maxOpen {
+			s.finishWatering(state, model.WateringStopReasonSafetyLimit)
+			s.raiseAlarm(state, model.AlarmKindMaxOpenTime, fmt.Sprintf("valve was open longer than %s", maxOpen))
+		}
+	}
+
+	if !s.watering() {
+		return
+	}
+
+	if s.lastWaterLevel < s.settings.MinWaterLevel {
+		s.stopWatering(model.WateringStopReasonLowWaterLevel)
+		s.raiseAlarm(nil, model.AlarmKindLowWaterLevel, fmt.Sprintf("pump stopped, water level %.0f%% is below %.0f%%", s.lastWaterLevel, s.settings.MinWaterLevel))
+		return
+	}
+
+	if s.dailyLimitReached() {
+		s.stopWatering(model.WateringStopReasonSafetyLimit)
+		s.raiseAlarm(nil, model.AlarmKindDailyPumpLimit, fmt.Sprintf("pump stopped, it already ran %s today", s.pumpRuntime.Round(time.Second)))
+	}
+}
+
+func (s *station) raiseAlarm(state *plantState, kind model.AlarmKind, message string) {
+	alarm := &model.Alarm{
+		StationID: s.id,
+		Kind:      kind,
+		Message:   message,
+	}
+
+	if state != nil {
+		alarm.Port = state.port.Port
+		if state.plantID != 0 {
+			plantID := state.plantID
+			alarm.PlantID = &plantID
+		}
+	}
+
+	s.controller.raiseAlarm(alarm)
+}
@@ -0,0 +1,459 @@
+package graph
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/actuators"
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"gorm.io/gorm/logger"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testStart is a Monday at midnight, so a simulated week starts with full days.
+var testStart = time.Date(2022, 6, 6, 0, 0, 0, 0, time.UTC)
+
+// testController runs the stations with fake sensors and boards on a manual clock, it only moves when the test advances it.
+type testController struct {
+	*controller
+	t     *testing.T
+	clock *clock.Manual
+}
+
+func newTestController(t *testing.T, stations ...sensors.StationSettings) *testController {
+	t.Helper()
+
+	return newTestControllerWithOptions(t, Options{}, stations...)
+}
+
+// newTestControllerWithOptions runs the stations with the options, only the paths, the clock and the seed are set by it.
+func newTestControllerWithOptions(t *testing.T, options Options, stations ...sensors.StationSettings) *testController {
+	t.Helper()
+
+	dir := t.TempDir()
+	settingsPath := filepath.Join(dir, "stationSettings.yml")
+	if err := sensors.SaveSettings(settingsPath, &sensors.Settings{Stations: stations}); err != nil {
+		t.Fatal(err)
+	}
+
+	manual := clock.NewManual(testStart)
+	options.FakeValues = true
+	options.SettingsPath = settingsPath
+	options.DBPath = filepath.Join(dir, "db.sqlite")
+	options.LogLevel = logger.Silent
+	options.Clock = manual
+	options.Seed = 1
+
+	c, err := NewController(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tc := &testController{
+		controller: c.(*controller),
+		t:          t,
+		clock:      manual,
+	}
+	t.Cleanup(tc.stop)
+	tc.settle()
+
+	return tc
+}
+
+// testStation returns station settings with a single port A, which are simulated if simulated is set.
+func testStation(id uint64, simulated bool) sensors.StationSettings {
+	settings := sensors.NewStationSettings()
+	settings.StationID = id
+	settings.PumpGPIO = 23
+	settings.Simulation.Enabled = simulated
+
+	port := sensors.NewPortSetting()
+	port.Port = "A"
+	port.ValveGPIO = 24
+	settings.Ports = []sensors.PortSetting{port}
+
+	return settings
+}
+
+func (tc *testController) stop() {
+	for _, s := range tc.stations {
+		s.Stop()
+	}
+
+	if db, err := tc.db.DB(); err == nil {
+		db.Close()
+	}
+}
+
+// inLoop runs f in the loop of the station and waits for it, the loop finished the iterations before then.
+func (tc *testController) inLoop(stationID uint64, f func(s *station)) {
+	tc.t.Helper()
+
+	s := tc.stations[stationID]
+	done := make(chan struct{})
+	if !s.post(func() {
+		f(s)
+		close(done)
+	}) {
+		tc.t.Fatalf("station %d was stopped", stationID)
+	}
+	<-done
+}
+
+// settle waits until the sensor workers sleep again and the stations handled everything they read.
+func (tc *testController) settle() {
+	tc.clock.BlockUntil(len(tc.stations))
+	for id := range tc.stations {
+		tc.inLoop(id, func(*station) {})
+	}
+}
+
+func (tc *testController) advance(d time.Duration) {
+	tc.clock.Advance(d)
+	tc.settle()
+}
+
+// run advances the clock until end, by idle while nothing is watered and by a second while anything is.
+func (tc *testController) run(end time.Time, idle time.Duration) {
+	for tc.clock.Now().Before(end) {
+		step := idle
+		if tc.busy() {
+			step = time.Second
+		}
+		if remaining := end.Sub(tc.clock.Now()); step > remaining {
+			step = remaining
+		}
+		tc.advance(step)
+	}
+}
+
+// busy reports whether any pump runs or any watering is going on.
+func (tc *testController) busy() bool {
+	busy := false
+	for id := range tc.stations {
+		tc.inLoop(id, func(s *station) {
+			if s.pump.IsOn() {
+				busy = true
+			}
+			for _, state := range s.plantStates {
+				if state.active() || state.timed != nil {
+					busy = true
+				}
+			}
+		})
+	}
+	return busy
+}
+
+func (tc *testController) board(stationID uint64) *actuators.FakeBoard {
+	return tc.fakeBoards[stationID].(*actuators.FakeBoard)
+}
+
+func (tc *testController) setMoisture(stationID uint64, port string, value float64) {
+	tc.t.Helper()
+
+	if err := tc.SetMoistureFakeValue(stationID, port, value); err != nil {
+		tc.t.Fatal(err)
+	}
+}
+
+func (tc *testController) createPlant(stationID uint64, port string, template *model.PlantTemplate, evaporationRate float64) *model.Plant {
+	tc.t.Helper()
+
+	if template.ID == 0 {
+		if err := tc.db.Create(template).Error; err != nil {
+			tc.t.Fatal(err)
+		}
+	}
+
+	plant := &model.Plant{
+		StationID:       stationID,
+		Active:          true,
+		Name:            "Plant " + port,
+		Port:            port,
+		TemplateID:      template.ID,
+		EvaporationRate: evaporationRate,
+	}
+	if err := tc.db.Create(plant).Error; err != nil {
+		tc.t.Fatal(err)
+	}
+
+	return plant
+}
+
+func (tc *testController) wateringEvents(plantID uint64) []*model.WateringEvent {
+	tc.t.Helper()
+
+	var events []*model.WateringEvent
+	if err := tc.db.Where("plant_id = ?", plantID).Order("started_at").Find(&events).Error; err != nil {
+		tc.t.Fatal(err)
+	}
+	return events
+}
+
+func (tc *testController) alarms(stationID uint64, kind model.AlarmKind) []*model.Alarm {
+	tc.t.Helper()
+
+	var alarms []*model.Alarm
+	if err := tc.db.Where("station_id = ? AND kind = ?", stationID, kind).Order("created_at").Find(&alarms).Error; err != nil {
+		tc.t.Fatal(err)
+	}
+	return alarms
+}
+
+// onTime sums up how long the relay at gpio was switched on per day.
+func onTime(board *actuators.FakeBoard, gpio int) map[string]time.Duration {
+	days := make(map[string]time.Duration)
+
+	var since time.Time
+	for _, change := range board.PinChanges(gpio) {
+		on := change.Level == actuators.Low
+		if on && since.IsZero() {
+			since = change.Time
+		} else if !on && !since.IsZero() {
+			days[since.Format("2006-01-02")] += change.Time.Sub(since)
+			since = time.Time{}
+		}
+	}
+
+	return days
+}
+
+func TestStationWatersThroughAWeek(t *testing.T) {
+	// station 1 has a plant whose dripper barely delivers, it stays thirsty and only the safety limits stop the pump
+	dripping := testStation(1, true)
+	dripping.MaxDailyPumpSeconds = 180
+	dripping.Simulation.EvaporationRate = 6
+	dripping.Simulation.ValveFlowRate = 0.01
+	dripping.Simulation.PumpDrainRate = 0.01
+	dripping.Ports[0].MaxOpenSeconds = 50
+	dripping.Ports[0].CooldownSeconds = 900
+
+	// station 2 waters a plant by its moisture and another one at a fixed time
+	garden := testStation(2, true)
+	garden.Simulation.PumpDrainRate = 0.01
+	portB := sensors.NewPortSetting()
+	portB.Port = "B"
+	portB.MoistureChannel = 2
+	portB.ValveGPIO = 25
+	garden.Ports = append(garden.Ports, portB)
+
+	tc := newTestController(t, dripping, garden)
+
+	template := &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}
+	thirsty := tc.createPlant(1, "A", template, 6)
+	tc.setMoisture(1, "A", 41)
+
+	watered := tc.createPlant(2, "A", template, 2)
+	tc.setMoisture(2, "A", 50)
+
+	scheduled := tc.createPlant(2, "B", template, 0.01)
+	schedule := &model.WateringSchedule{
+		StationID: 2,
+		PlantID:   &scheduled.ID,
+		Kind:      model.ScheduleKindFixedTime,
+		Start:     "06:32",
+		Seconds:   20,
+		Enabled:   true,
+	}
+	if err := tc.db.Create(schedule).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// idle steps of 5 minutes hardly ever land in the minute of the schedule, the week ends a minute early
+	// so the thirsty plant does not start watering again when the daily pump runtime resets
+	tc.run(testStart.AddDate(0, 0, 7).Add(-time.Minute), 5*time.Minute)
+
+	cooldown := time.Duration(dripping.Ports[0].CooldownSeconds) * time.Second
+	events := tc.wateringEvents(thirsty.ID)
+	days := make(map[string]int)
+	for i, event := range events {
+		if event.EndedAt == nil {
+			t.Fatalf("watering %d of the thirsty plant did not end", event.ID)
+		}
+		if event.Reason != model.WateringReasonThreshold {
+			t.Errorf("watering %d of the thirsty plant has reason %s, want %s", event.ID, event.Reason, model.WateringReasonThreshold)
+		}
+		if d := *event.Duration(); d > float64(dripping.Ports[0].MaxOpenSeconds) {
+			t.Errorf("watering %d of the thirsty plant took %.0fs, longer than the max open time", event.ID, d)
+		}
+		if i > 0 {
+			if pause := event.StartedAt.Sub(*events[i-1].EndedAt); pause < cooldown {
+				t.Errorf("watering %d started %s after the one before, within the cooldown of %s", event.ID, pause, cooldown)
+			}
+		}
+		days[event.StartedAt.Format("2006-01-02")]++
+	}
+	if len(days) != 7 {
+		t.Errorf("thirsty plant was watered on %d days, want 7", len(days))
+	}
+
+	limit := time.Duration(dripping.MaxDailyPumpSeconds) * time.Second
+	runtimes := onTime(tc.board(1), dripping.PumpGPIO)
+	for day, runtime := range runtimes {
+		if runtime > limit || runtime < limit-time.Second {
+			t.Errorf("pump of station 1 ran %s on %s, want the daily limit of %s", runtime, day, limit)
+		}
+	}
+	if len(runtimes) != 7 {
+		t.Errorf("pump of station 1 ran on %d days, want 7", len(runtimes))
+	}
+	if alarms := tc.alarms(1, model.AlarmKindDailyPumpLimit); len(alarms) != 7 {
+		t.Errorf("got %d daily pump limit alarms, want one per day", len(alarms))
+	}
+	if len(tc.alarms(1, model.AlarmKindMaxOpenTime)) == 0 {
+		t.Error("got no max open time alarm for the thirsty plant")
+	}
+	if len(tc.alarms(1, model.AlarmKindCooldown)) == 0 {
+		t.Error("got no cooldown alarm for the thirsty plant")
+	}
+
+	events = tc.wateringEvents(watered.ID)
+	if len(events) < 14 {
+		t.Errorf("plant drying by 2%% an hour was watered %d times in a week, want at least 14", len(events))
+	}
+	for _, event := range events {
+		if event.MoistureAfter == nil || *event.MoistureAfter < template.StopThreshold {
+			t.Errorf("watering %d did not reach the stop threshold, moisture after is %v", event.ID, event.MoistureAfter)
+		}
+	}
+
+	events = tc.wateringEvents(scheduled.ID)
+	if len(events) != 7 {
+		t.Fatalf("scheduled plant was watered %d times in a week, want 7", len(events))
+	}
+	for i, event := range events {
+		due := testStart.AddDate(0, 0, i).Add(6*time.Hour + 32*time.Minute)
+		if event.Reason != model.WateringReasonSchedule || event.StartedAt.Before(due) || event.StartedAt.After(due.Add(5*time.Minute)) {
+			t.Errorf("scheduled watering %d started at %s with reason %s, want %s after %s", event.ID, event.StartedAt, event.Reason, model.WateringReasonSchedule, due)
+		}
+		if d := *event.Duration(); d < 20 || d > 21 {
+			t.Errorf("scheduled watering %d took %.0fs, want 20s", event.ID, d)
+		}
+	}
+}
+
+// A recording of a moisture sensor which jitters around the water threshold while the plant dries and then rises
+// slowly while it is watered, like the one of the field report about a chattering valve.
+func TestReplayOfAJitteringSensorWatersOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	recorder, err := sensors.NewRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorded := time.Date(2022, 5, 1, 18, 0, 0, 0, time.UTC)
+	for i := 0; i < 120; i++ {
+		moisture := 62.0
+		switch {
+		case i < 30 && i%2 == 0:
+			moisture = 41
+		case i < 30:
+			moisture = 39.5
+		case i < 60:
+			moisture = 39 + float64(i-30)*0.8
+		}
+
+		at := recorded.Add(time.Duration(i) * time.Second)
+		for _, data := range []sensors.SensorData{
+			{SensorName: sensors.WaterLevelSensorName, Value: 80},
+			{SensorName: sensors.MoistureSensorName, Value: moisture, Port: sensors.PortSetting{Port: "A"}},
+		} {
+			if err = recorder.Record(at, data); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err = recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	settings := testStation(1, false)
+	settings.ReplayFile = path
+	tc := newTestController(t, settings)
+	board := tc.board(1)
+
+	plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	for i := 0; i < 130; i++ {
+		tc.advance(time.Second)
+	}
+
+	if n := switchedOn(board, 24); n != 1 {
+		t.Errorf("valve was opened %d times, want once", n)
+	}
+	if board.Level(23) != actuators.High || board.Level(24) != actuators.High {
+		t.Error("pump or valve is still on after the recording")
+	}
+
+	events := tc.wateringEvents(plant.ID)
+	if len(events) != 1 {
+		t.Fatalf("got %d watering events, want 1", len(events))
+	}
+	event := events[0]
+	if !event.StartedAt.Equal(testStart.Add(time.Second)) || event.MoistureBefore != 39.5 {
+		t.Errorf("watering started at %s with %v, want at the first reading below the threshold", event.StartedAt, event.MoistureBefore)
+	}
+	// 39 + 27 * 0.8 is the first reading of at least 60
+	if event.EndedAt == nil || !event.EndedAt.Equal(testStart.Add(57*time.Second)) {
+		t.Errorf("watering ended at %v, want at the first reading above the stop threshold", event.EndedAt)
+	}
+}
+
+func TestReplayOfAFailingSensorFaultsIt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	recorder, err := sensors.NewRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the probe works for 5 seconds, then its cable breaks and it only reads implausible raw values
+	recorded := time.Date(2022, 5, 1, 18, 0, 0, 0, time.UTC)
+	for i := 0; i < 20; i++ {
+		moisture := sensors.SensorData{SensorName: sensors.MoistureSensorName, Value: 30, Port: sensors.PortSetting{Port: "A"}}
+		if i >= 5 {
+			moisture.Value = 0
+			moisture.Err = &sensors.OutOfRangeError{Raw: 0, Min: 1, Max: 4094}
+		}
+
+		at := recorded.Add(time.Duration(i) * time.Second)
+		for _, data := range []sensors.SensorData{{SensorName: sensors.WaterLevelSensorName, Value: 80}, moisture} {
+			if err = recorder.Record(at, data); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err = recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	settings := testStation(1, false)
+	settings.ReplayFile = path
+	tc := newTestController(t, settings)
+	board := tc.board(1)
+
+	plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	for i := 0; i < 25; i++ {
+		tc.advance(time.Second)
+	}
+
+	var fault sensors.Fault
+	tc.inLoop(1, func(s *station) {
+		fault = s.health[sensorKey(sensors.MoistureSensorName, "A")].Fault
+	})
+	if fault != sensors.FaultOutOfRange {
+		t.Errorf("moisture sensor fault is %q after the replay, want %q", fault, sensors.FaultOutOfRange)
+	}
+	if alerts := tc.alerts(model.AlarmKindSensorFault); len(alerts) != 1 {
+		t.Errorf("got %d sensor fault alerts, want 1", len(alerts))
+	}
+
+	// the watering started while the probe worked stops once it is faulted
+	events := tc.wateringEvents(plant.ID)
+	if len(events) != 1 || events[0].EndedAt == nil {
+		t.Fatalf("got watering events %+v, want a single finished one", events)
+	}
+	if board.Level(24) != actuators.High {
+		t.Error("valve is still open with a faulted moisture sensor")
+	}
+}
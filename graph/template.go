@@ -0,0 +1,54 @@
+package graph
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+)
+
+// templateFromInput validates the input and applies it to the template.
+// Templates without stop threshold stop at their water threshold, templates without pulse seconds water continuously.
+func templateFromInput(input model.PlantTemplateInput, template *model.PlantTemplate) error {
+	v := &validation{}
+	v.notEmpty("input.name", input.Name)
+	v.percentage("input.waterThreshold", input.WaterThreshold)
+
+	stopThreshold := input.WaterThreshold
+	if input.StopThreshold != nil {
+		stopThreshold = *input.StopThreshold
+		if v.percentage("input.stopThreshold", stopThreshold) {
+			v.check(stopThreshold >= input.WaterThreshold, "input.stopThreshold", "must not be lower than the water threshold %g", input.WaterThreshold)
+		}
+	}
+
+	pulse, soak, maxPulses := 0, 0, 0
+	if input.PulseSeconds != nil {
+		pulse = *input.PulseSeconds
+		v.notNegative("input.pulseSeconds", float64(pulse))
+	}
+	if input.SoakSeconds != nil {
+		soak = *input.SoakSeconds
+		v.notNegative("input.soakSeconds", float64(soak))
+	}
+	if input.MaxPulses != nil {
+		maxPulses = *input.MaxPulses
+		v.notNegative("input.maxPulses", float64(maxPulses))
+	}
+
+	if pulse > 0 {
+		v.check(soak > 0, "input.soakSeconds", "pulse watering needs soak seconds")
+	} else {
+		soak, maxPulses = 0, 0
+	}
+
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	template.Name = input.Name
+	template.WaterThreshold = input.WaterThreshold
+	template.StopThreshold = stopThreshold
+	template.PulseSeconds = pulse
+	template.SoakSeconds = soak
+	template.MaxPulses = maxPulses
+
+	return nil
+}
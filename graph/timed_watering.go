@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"context"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"time"
+)
+
+// timedWatering is a watering requested through the API or started by a fixed time schedule,
+// it overrides the threshold decisions of the port until it finished. Its result is nil if nobody waits for it.
+type timedWatering struct {
+	until  time.Time
+	target *float64
+	result chan *model.WateringResult
+}
+
+func (m *timedWatering) finish(event *model.WateringEvent, reason model.WateringStopReason, moisture float64) {
+	if m.result == nil {
+		return
+	}
+
+	m.result <- &model.WateringResult{
+		Event:         event,
+		StopReason:    reason,
+		ReachedTarget: reason == model.WateringStopReasonTargetReached,
+		Moisture:      moisture,
+	}
+}
+
+// WaterPlant waters the plant for the given duration, or until its moisture reaches target if it is not nil.
+// It blocks until the watering finished and respects the same water level guard and safety limits as the automatic watering.
+func (c *controller) WaterPlant(ctx context.Context, plantID uint64, duration time.Duration, target *float64) (*model.WateringResult, error) {
+	var plant model.Plant
+	if err := findByID(c.db.Preload("Template"), &plant, "plant", plantID); err != nil {
+		return nil, err
+	}
+
+	if !plant.Active {
+		return nil, conflict("plant %d is not active", plantID)
+	}
+
+	s, err := c.station(plant.StationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Water(ctx, &plant, duration, target)
+}
+
+func (s *station) Water(ctx context.Context, plant *model.Plant, duration time.Duration, target *float64) (*model.WateringResult, error) {
+	state, ok := s.plantStates[plant.Port]
+	if !ok {
+		return nil, notFound("station %d has no port %s", s.id, plant.Port)
+	}
+
+	if duration <= 0 {
+		return nil, validationError("duration must be greater than 0 seconds")
+	}
+
+	maxOpen := time.Duration(state.port.MaxOpenSeconds) * time.Second
+	if duration > maxOpen {
+		return nil, validationError("duration exceeds the maximum open time of %s of port %s", maxOpen, plant.Port)
+	}
+
+	result := make(chan *model.WateringResult, 1)
+	started := make(chan error, 1)
+
+	posted := s.post(func() {
+		if state.active() {
+			started <- conflict("port %s is already watering", plant.Port)
+			return
+		}
+
+		if reason := s.pumpBlocked(); reason != "" {
+			started <- conflict("plant can not be watered, %s", reason)
+			return
+		}
+
+		cooldown := time.Duration(state.port.CooldownSeconds) * time.Second
+		if !state.closedAt.IsZero() && s.clock.Since(state.closedAt) < cooldown {
+			started <- conflict("plant can not be watered, the valve of port %s has to cool down until %s", plant.Port, state.closedAt.Add(cooldown).Format("15:04:05"))
+			return
+		}
+
+		if target != nil && s.sensorFaulted(sensors.MoistureSensorName, plant.Port) {
+			started <- conflict("plant can not be watered up to a moisture, the moisture sensor of port %s is faulted", plant.Port)
+			return
+		}
+
+		if target != nil && state.currentMoisture >= *target {
+			started <- nil
+			result <- &model.WateringResult{
+				StopReason:    model.WateringStopReasonTargetReached,
+				ReachedTarget: true,
+				Moisture:      state.currentMoisture,
+			}
+			return
+		}
+
+		if !s.startTimedWatering(state, plant, model.WateringReasonManual, duration, target, result) {
+			started <- hardwareError("valve of port %s could not be opened", plant.Port)
+			return
+		}
+
+		started <- nil
+	})
+	if !posted {
+		return nil, conflict("station %d was stopped", s.id)
+	}
+
+	if err := <-started; err != nil {
+		return nil, err
+	}
+
+	select {
+	case r := <-result:
+		return r, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// startTimedWatering opens the valve of the port for the given duration, it has to be called from the station loop.
+func (s *station) startTimedWatering(state *plantState, plant *model.Plant, reason model.WateringReason, duration time.Duration, target *float64, result chan *model.WateringResult) bool {
+	state.timed = &timedWatering{
+		until:  s.clock.Now().Add(duration),
+		target: target,
+		result: result,
+	}
+	s.startWatering(state, plant, reason, wateringPlan{})
+	if !state.active() {
+		state.timed = nil
+		return false
+	}
+
+	// wakes up the loop at the deadline, so the valve closes in time even without new sensor data
+	s.clock.AfterFunc(duration, func() {
+		s.post(func() {})
+	})
+
+	return true
+}
+
+// checkTimedWaterings closes the valves of manual and scheduled waterings which reached their target or time.
+func (s *station) checkTimedWaterings() {
+	now := s.clock.Now()
+
+	for _, state := range s.plantStates {
+		m := state.timed
+		if m == nil {
+			continue
+		}
+
+		if m.target != nil && state.currentMoisture >= *m.target {
+			s.finishWatering(state, model.WateringStopReasonTargetReached)
+			continue
+		}
+
+		if !now.Before(m.until) {
+			if m.target != nil {
+				s.finishWatering(state, model.WateringStopReasonTimeout)
+			} else {
+				s.finishWatering(state, model.WateringStopReasonDurationElapsed)
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"context"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"testing"
+	"time"
+)
+
+type waterResult struct {
+	result *model.WateringResult
+	err    error
+}
+
+// water starts a manual watering of the plant and waits until it started or was refused.
+func (tc *testController) water(plant *model.Plant, duration time.Duration) <-chan waterResult {
+	tc.t.Helper()
+
+	done := make(chan waterResult, 1)
+	go func() {
+		result, err := tc.WaterPlant(context.Background(), plant.ID, duration, nil)
+		done <- waterResult{result, err}
+	}()
+
+	for {
+		select {
+		case r := <-done:
+			// refused, or finished right away
+			done <- r
+			return done
+		default:
+		}
+
+		started := false
+		tc.inLoop(plant.StationID, func(s *station) {
+			started = s.plantStates[plant.Port].timed != nil
+		})
+		if started {
+			return done
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestManualWateringRunsForItsDuration(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	board := tc.board(1)
+
+	plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	tc.setMoisture(1, "A", 50)
+	tc.advance(time.Second)
+
+	done := tc.water(plant, 5*time.Second)
+	assertRelays(t, board, true, true)
+
+	tc.advance(4 * time.Second)
+	assertRelays(t, board, true, true)
+
+	tc.advance(time.Second)
+	assertRelays(t, board, false, false)
+
+	r := <-done
+	if r.err != nil {
+		t.Fatal(r.err)
+	}
+	if r.result.StopReason != model.WateringStopReasonDurationElapsed || r.result.Event == nil || r.result.Event.Reason != model.WateringReasonManual {
+		t.Errorf("got result %+v, want a manual watering stopped after its time", r.result)
+	}
+}
+
+func TestManualWateringRespectsTheCooldown(t *testing.T) {
+	settings := testStation(1, false)
+	settings.Ports[0].MaxOpenSeconds = 10
+	tc := newTestController(t, settings)
+	board := tc.board(1)
+
+	plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	tc.setMoisture(1, "A", 30)
+	for i := 0; i < 11; i++ {
+		tc.advance(time.Second)
+	}
+	assertRelays(t, board, false, false)
+	tc.setMoisture(1, "A", 50)
+
+	// the max open time closed the valve, opening it by hand right away would get around the limit
+	select {
+	case r := <-tc.water(plant, 5*time.Second):
+		if Code(r.err) != ErrorCodeConflict {
+			t.Fatalf("got %v, want a conflict while the valve cools down", r.err)
+		}
+	default:
+		t.Fatal("manual watering started while the valve cools down")
+	}
+	assertRelays(t, board, false, false)
+
+	tc.advance(time.Duration(settings.Ports[0].CooldownSeconds) * time.Second)
+	done := tc.water(plant, 5*time.Second)
+	assertRelays(t, board, true, true)
+
+	tc.advance(5 * time.Second)
+	if r := <-done; r.err != nil {
+		t.Fatal(r.err)
+	}
+	assertRelays(t, board, false, false)
+}
@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"github.com/mattn/go-sqlite3"
+	"strings"
+)
+
+// FieldError is the reason the value of one input field was rejected.
+// Field is the path of the field within the arguments, like input.name or input.ports.1.valveGPIO.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// validation collects the field errors of an input, so a client gets all of them at once.
+type validation struct {
+	fields []FieldError
+}
+
+func (v *validation) add(field string, format string, args ...interface{}) {
+	v.fields = append(v.fields, FieldError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// check adds the field error if ok is false and returns ok.
+func (v *validation) check(ok bool, field string, format string, args ...interface{}) bool {
+	if !ok {
+		v.add(field, format, args...)
+	}
+	return ok
+}
+
+func (v *validation) notEmpty(field string, value string) bool {
+	return v.check(strings.TrimSpace(value) != "", field, "must not be empty")
+}
+
+func (v *validation) notNegative(field string, value float64) bool {
+	return v.check(value >= 0, field, "must not be negative")
+}
+
+func (v *validation) percentage(field string, value float64) bool {
+	return v.check(value >= 0 && value <= 100, field, "%g is not between 0 and 100", value)
+}
+
+func (v *validation) between(field string, value int, min int, max int) bool {
+	return v.check(value >= min && value <= max, field, "%d is not between %d and %d", value, min, max)
+}
+
+// err returns a validation error with all collected field errors, or nil if there are none.
+func (v *validation) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return fieldsError(ErrorCodeValidation, v.fields)
+}
+
+func fieldsError(code ErrorCode, fields []FieldError) error {
+	messages := make([]string, len(fields))
+	for i, f := range fields {
+		messages[i] = f.Field + ": " + f.Message
+	}
+
+	return &Error{
+		Code:   code,
+		Fields: fields,
+		err:    errors.New(strings.Join(messages, ", ")),
+	}
+}
+
+// fieldConflict is a conflict caused by the value of a single field, like a port which is already taken.
+func fieldConflict(field string, format string, args ...interface{}) error {
+	return fieldsError(ErrorCodeConflict, []FieldError{{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	}})
+}
+
+// field joins the path of a nested input field.
+func field(path ...interface{}) string {
+	parts := make([]string, len(path))
+	for i, p := range path {
+		parts[i] = fmt.Sprint(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// dbError reports violated unique constraints as conflicts. Checks in front of a write can not see concurrent writes, the constraints can.
+// A transaction which lost the race for the database against a concurrent one is a conflict as well.
+func dbError(err error) error {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+		return conflict("%w", err)
+	}
+	if errors.As(err, &sqliteErr) && (sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked) {
+		return conflict("the database is busy with a concurrent change, try again: %w", err)
+	}
+	return err
+}
@@ -0,0 +1,307 @@
+package graph
+
+import (
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/actuators"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"log"
+	"time"
+)
+
+type wateringPhase int
+
+const (
+	// wateringIdle means the valve is closed and no watering is going on
+	wateringIdle wateringPhase = iota
+	// wateringOpen means the valve is open, either for a continuous watering or for a single pulse
+	wateringOpen
+	// wateringSoaking means the valve is closed between two pulses so the water can soak in
+	wateringSoaking
+)
+
+func (p wateringPhase) String() string {
+	switch p {
+	case wateringOpen:
+		return "open"
+	case wateringSoaking:
+		return "soaking"
+	default:
+		return "idle"
+	}
+}
+
+// wateringPlan describes how a watering is done, it is taken from the template when the watering starts.
+// A plan without pulse waters continuously until the stop threshold is reached.
+type wateringPlan struct {
+	stopThreshold float64
+	pulse         time.Duration
+	soak          time.Duration
+	maxPulses     int
+}
+
+func templateWateringPlan(template *model.PlantTemplate) wateringPlan {
+	return wateringPlan{
+		stopThreshold: template.WateringStopThreshold(),
+		pulse:         time.Duration(template.PulseSeconds) * time.Second,
+		soak:          time.Duration(template.SoakSeconds) * time.Second,
+		maxPulses:     template.MaxPulses,
+	}
+}
+
+type plantState struct {
+	port  sensors.PortSetting
+	valve *actuators.Relay
+
+	currentMoisture float64
+	measured        bool
+	status          string
+	activePlantID   uint64
+	thirsty         bool
+
+	phase         wateringPhase
+	phaseSince    time.Time
+	pulses        int
+	plan          wateringPlan
+	plantID       uint64
+	closedAt      time.Time
+	cooldownAlarm bool
+	wateringEvent *model.WateringEvent
+	timed         *timedWatering
+}
+
+func (state *plantState) valveOpen() bool {
+	return state.phase == wateringOpen
+}
+
+func (state *plantState) active() bool {
+	return state.phase != wateringIdle
+}
+
+// evaluatePlant decides with the latest moisture reading whether a watering of the port starts or is finished.
+func (s *station) evaluatePlant(state *plantState) {
+	if state.timed != nil {
+		return
+	}
+
+	var plant model.Plant
+	s.controller.db.Preload("Template").Where("station_id = ? AND port = ? AND active = ?", s.id, state.port.Port, true).Limit(1).Find(&plant)
+
+	if !plant.Active {
+		state.activePlantID = 0
+		state.thirsty = false
+		s.logStatus(state, "plant not active")
+		if state.active() {
+			s.finishWatering(state, model.WateringStopReasonStopped)
+		}
+		return
+	}
+
+	state.activePlantID = plant.ID
+	state.thirsty = state.currentMoisture <= plant.Template.WaterThreshold
+
+	if s.sensorFaulted(sensors.MoistureSensorName, state.port.Port) {
+		s.logStatus(state, "moisture sensor is faulted, not watering")
+		if state.active() {
+			s.finishWatering(state, model.WateringStopReasonSensorFault)
+		}
+		return
+	}
+
+	// once started the watering goes on until the stop threshold is reached, so the valve does not chatter around a single value
+	if state.active() {
+		if state.currentMoisture >= state.plan.stopThreshold {
+			s.logStatus(state, fmt.Sprintf("plant watered up to %.1f", state.currentMoisture))
+			s.finishWatering(state, model.WateringStopReasonTargetReached)
+		}
+		return
+	}
+
+	if !state.thirsty {
+		s.logStatus(state, "plant not thirsty")
+		return
+	}
+
+	// threshold waterings outside of the allowed windows wait for the next window, the next reading then starts them
+	now := s.clock.Now()
+	next, ok := s.nextAllowedWatering(plant.ID, now)
+	if !ok {
+		s.logStatus(state, "plant is thirsty but no watering window is within the next week")
+		return
+	}
+	if next.After(now) {
+		s.logStatus(state, fmt.Sprintf("plant is thirsty, watering queued until %s", next.Format("Mon 15:04")))
+		return
+	}
+
+	if reason := s.wateringBlocked(state); reason != "" {
+		s.logStatus(state, reason)
+		return
+	}
+
+	s.logStatus(state, fmt.Sprintf("plant is thirsty at %.1f, watering", state.currentMoisture))
+	s.startWatering(state, &plant, model.WateringReasonThreshold, templateWateringPlan(&plant.Template))
+}
+
+// advanceWaterings moves pulse waterings between their open and soaking phase.
+func (s *station) advanceWaterings() {
+	now := s.clock.Now()
+
+	for _, state := range s.plantStates {
+		if state.plan.pulse == 0 {
+			continue
+		}
+
+		switch state.phase {
+		case wateringOpen:
+			if now.Sub(state.phaseSince) < state.plan.pulse {
+				continue
+			}
+
+			if err := state.valve.Off(); err != nil {
+				fmt.Println(err)
+			}
+			state.phase = wateringSoaking
+			state.phaseSince = now
+			s.logStatus(state, fmt.Sprintf("pulse %d done, soaking", state.pulses))
+
+		case wateringSoaking:
+			if now.Sub(state.phaseSince) < state.plan.soak {
+				continue
+			}
+
+			if state.currentMoisture >= state.plan.stopThreshold {
+				s.logStatus(state, fmt.Sprintf("plant watered up to %.1f", state.currentMoisture))
+				s.finishWatering(state, model.WateringStopReasonTargetReached)
+				continue
+			}
+
+			if state.plan.maxPulses > 0 && state.pulses >= state.plan.maxPulses {
+				s.logStatus(state, fmt.Sprintf("plant got %d pulses and is still at %.1f", state.pulses, state.currentMoisture))
+				s.finishWatering(state, model.WateringStopReasonMaxPulses)
+				continue
+			}
+
+			if reason := s.pumpBlocked(); reason != "" {
+				s.logStatus(state, "next pulse cancelled, "+reason)
+				if s.lastWaterLevel < s.settings.MinWaterLevel {
+					s.finishWatering(state, model.WateringStopReasonLowWaterLevel)
+				} else {
+					s.finishWatering(state, model.WateringStopReasonSafetyLimit)
+				}
+				continue
+			}
+
+			if err := state.valve.On(); err != nil {
+				fmt.Println(err)
+				s.finishWatering(state, model.WateringStopReasonStopped)
+				continue
+			}
+			state.pulses++
+			state.phase = wateringOpen
+			state.phaseSince = now
+			s.logStatus(state, fmt.Sprintf("pulse %d", state.pulses))
+		}
+	}
+}
+
+// logStatus logs the status of the port whenever it changes.
+func (s *station) logStatus(state *plantState, status string) {
+	if state.status != status {
+		log.Println("Station", s.id, "Port", state.port.Port, status)
+		state.status = status
+	}
+}
+
+// wateringBlocked returns why the valve of the port must not be opened right now, or an empty string if it may.
+func (s *station) wateringBlocked(state *plantState) string {
+	if reason := s.pumpBlocked(); reason != "" {
+		return "plant is thirsty but " + reason
+	}
+
+	cooldown := time.Duration(state.port.CooldownSeconds) * time.Second
+	if !state.closedAt.IsZero() && s.clock.Since(state.closedAt) < cooldown {
+		if !state.cooldownAlarm {
+			state.cooldownAlarm = true
+			s.raiseAlarm(state, model.AlarmKindCooldown, fmt.Sprintf("plant is thirsty but the valve has to cool down until %s", state.closedAt.Add(cooldown).Format("15:04:05")))
+		}
+		return "plant is thirsty but the valve is cooling down"
+	}
+
+	return ""
+}
+
+// pumpBlocked returns why the pump must not be started right now, or an empty string if it may.
+func (s *station) pumpBlocked() string {
+	if s.sensorFaulted(sensors.WaterLevelSensorName, "") {
+		return "the water level sensor is faulted"
+	}
+
+	if s.lastWaterLevel < s.settings.MinWaterLevel {
+		return "no water is there :("
+	}
+
+	if s.dailyLimitReached() {
+		return "the daily pump runtime is used up"
+	}
+
+	return ""
+}
+
+func (s *station) startWatering(state *plantState, plant *model.Plant, reason model.WateringReason, plan wateringPlan) {
+	if err := state.valve.On(); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	state.phase = wateringOpen
+	state.phaseSince = s.clock.Now()
+	state.pulses = 1
+	state.plan = plan
+	state.plantID = plant.ID
+	state.wateringEvent = s.controller.startWateringEvent(plant, reason, state.currentMoisture)
+}
+
+func (s *station) finishWatering(state *plantState, reason model.WateringStopReason) {
+	if err := state.valve.Off(); err != nil {
+		fmt.Println(err)
+	}
+
+	event := state.wateringEvent
+
+	if state.active() {
+		state.phase = wateringIdle
+		state.phaseSince = s.clock.Now()
+		state.closedAt = state.phaseSince
+		state.cooldownAlarm = false
+	}
+
+	if state.wateringEvent != nil {
+		s.controller.finishWateringEvent(state.wateringEvent, state.currentMoisture, state.pulses)
+		state.wateringEvent = nil
+	}
+
+	if state.timed != nil {
+		state.timed.finish(event, reason, state.currentMoisture)
+		state.timed = nil
+	}
+}
+
+// stopWatering finishes all waterings of the station.
+func (s *station) stopWatering(reason model.WateringStopReason) {
+	for _, state := range s.plantStates {
+		if state.active() {
+			s.finishWatering(state, reason)
+		}
+	}
+}
+
+// watering reports whether any valve of the station is open, which is when the pump has to run.
+func (s *station) watering() bool {
+	for _, state := range s.plantStates {
+		if state.valveOpen() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package graph
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/actuators"
+	"github.com/ZamarianPatrick/lazypig-backend/graph/model"
+	"testing"
+	"time"
+)
+
+func (tc *testController) setWaterLevel(stationID uint64, value float64) {
+	tc.stations[stationID].waterLevelFake.SetValue(value)
+}
+
+// assertRelays checks the pump and the valve of port A of a station built by testStation, the relays are low active.
+func assertRelays(t *testing.T, board *actuators.FakeBoard, pump bool, valve bool) {
+	t.Helper()
+
+	if on := board.Level(23) == actuators.Low; on != pump {
+		t.Errorf("pump is on: %v, want %v", on, pump)
+	}
+	if on := board.Level(24) == actuators.Low; on != valve {
+		t.Errorf("valve is open: %v, want %v", on, valve)
+	}
+}
+
+// switchedOn counts how often the relay at gpio was switched on.
+func switchedOn(board *actuators.FakeBoard, gpio int) int {
+	n := 0
+	on := false
+	for _, change := range board.PinChanges(gpio) {
+		if change.Level == actuators.Low && !on {
+			n++
+		}
+		on = change.Level == actuators.Low
+	}
+	return n
+}
+
+func TestThirstyPlantIsWateredUntilTheStopThreshold(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	board := tc.board(1)
+
+	plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	tc.advance(time.Second)
+	assertRelays(t, board, false, false)
+
+	tc.setMoisture(1, "A", 30)
+	tc.advance(time.Second)
+	assertRelays(t, board, true, true)
+
+	// between the thresholds the watering goes on, so the valve does not chatter
+	tc.setMoisture(1, "A", 50)
+	tc.advance(time.Second)
+	assertRelays(t, board, true, true)
+
+	tc.setMoisture(1, "A", 61)
+	tc.advance(time.Second)
+	assertRelays(t, board, false, false)
+
+	tc.setMoisture(1, "A", 50)
+	tc.advance(time.Second)
+	assertRelays(t, board, false, false)
+
+	events := tc.wateringEvents(plant.ID)
+	if len(events) != 1 {
+		t.Fatalf("got %d watering events, want 1", len(events))
+	}
+	event := events[0]
+	if event.Reason != model.WateringReasonThreshold || event.EndedAt == nil || *event.Duration() != 2 {
+		t.Errorf("got watering %+v, want a threshold watering of 2s", event)
+	}
+	if event.MoistureBefore > 40 || event.MoistureAfter == nil || *event.MoistureAfter < 60 {
+		t.Errorf("watering went from %v to %v, want from below 40 to 60 or more", event.MoistureBefore, event.MoistureAfter)
+	}
+}
+
+func TestPulseWateringSoaksBetweenPulses(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	board := tc.board(1)
+
+	template := &model.PlantTemplate{Name: "Cactus", WaterThreshold: 40, StopThreshold: 60, PulseSeconds: 5, SoakSeconds: 10, MaxPulses: 3}
+	plant := tc.createPlant(1, "A", template, 0)
+	tc.setMoisture(1, "A", 30)
+
+	for i := 0; i < 60; i++ {
+		tc.advance(time.Second)
+	}
+	assertRelays(t, board, false, false)
+
+	if n := switchedOn(board, 24); n != 3 {
+		t.Errorf("valve was opened %d times, want 3 pulses", n)
+	}
+	if runtime := onTime(board, 23)[testStart.Format("2006-01-02")]; runtime != 15*time.Second {
+		t.Errorf("pump ran %s, want 3 pulses of 5s", runtime)
+	}
+
+	events := tc.wateringEvents(plant.ID)
+	if len(events) != 1 || events[0].Pulses != 3 {
+		t.Fatalf("got watering events %+v, want a single one with 3 pulses", events)
+	}
+	// 3 pulses with 2 soaks in between, the last soak is waited for before the watering gives up
+	if d := *events[0].Duration(); d != 3*5+3*10 {
+		t.Errorf("watering took %.0fs, want 45s", d)
+	}
+}
+
+func TestValveClosesAfterTheMaxOpenTime(t *testing.T) {
+	settings := testStation(1, false)
+	settings.Ports[0].MaxOpenSeconds = 10
+	tc := newTestController(t, settings)
+	board := tc.board(1)
+
+	tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	tc.setMoisture(1, "A", 30)
+
+	// the first second opens the valve, it may stay open for 10 more
+	for i := 0; i < 10; i++ {
+		tc.advance(time.Second)
+	}
+	assertRelays(t, board, true, true)
+
+	tc.advance(time.Second)
+	assertRelays(t, board, false, false)
+	if alarms := tc.alarms(1, model.AlarmKindMaxOpenTime); len(alarms) != 1 || alarms[0].Port != "A" {
+		t.Errorf("got max open time alarms %+v, want one for port A", alarms)
+	}
+
+	// the plant is still thirsty, but the valve has to cool down first
+	tc.advance(time.Duration(settings.Ports[0].CooldownSeconds-1) * time.Second)
+	assertRelays(t, board, false, false)
+	if alarms := tc.alarms(1, model.AlarmKindCooldown); len(alarms) != 1 {
+		t.Errorf("got %d cooldown alarms, want 1", len(alarms))
+	}
+
+	tc.advance(2 * time.Second)
+	assertRelays(t, board, true, true)
+}
+
+func TestPumpStopsWhenTheWaterRunsOut(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	board := tc.board(1)
+
+	plant := tc.createPlant(1, "A", &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}, 0)
+	tc.setMoisture(1, "A", 30)
+	tc.advance(time.Second)
+	assertRelays(t, board, true, true)
+
+	tc.setWaterLevel(1, 2)
+	tc.advance(time.Second)
+	assertRelays(t, board, false, false)
+	if alarms := tc.alarms(1, model.AlarmKindLowWaterLevel); len(alarms) != 1 {
+		t.Errorf("got %d low water level alarms, want 1", len(alarms))
+	}
+
+	// without water the thirsty plant waits, also after the cooldown
+	tc.advance(time.Hour)
+	assertRelays(t, board, false, false)
+
+	tc.setWaterLevel(1, 80)
+	tc.advance(time.Second)
+	assertRelays(t, board, true, true)
+
+	if events := tc.wateringEvents(plant.ID); len(events) != 2 {
+		t.Errorf("got %d watering events, want 2", len(events))
+	}
+}
+
+// Plants which were on a port before stay in the database inactive, only the active one may decide about the valve.
+func TestOnlyTheActivePlantOfAPortIsWatered(t *testing.T) {
+	tc := newTestController(t, testStation(1, false))
+	board := tc.board(1)
+
+	template := &model.PlantTemplate{Name: "Herbs", WaterThreshold: 40, StopThreshold: 60}
+	previous := tc.createPlant(1, "A", template, 0)
+	if err := tc.db.Model(previous).Update("active", false).Error; err != nil {
+		t.Fatal(err)
+	}
+	current := tc.createPlant(1, "A", template, 0)
+
+	tc.setMoisture(1, "A", 30)
+	tc.advance(time.Second)
+	assertRelays(t, board, true, true)
+
+	if events := tc.wateringEvents(previous.ID); len(events) != 0 {
+		t.Errorf("inactive plant got %d watering events", len(events))
+	}
+	if events := tc.wateringEvents(current.ID); len(events) != 1 {
+		t.Errorf("active plant got %d watering events, want 1", len(events))
+	}
+
+	var readings []*model.Reading
+	tc.db.Where("sensor_name = ? AND plant_id = ?", "Moisture", current.ID).Find(&readings)
+	if len(readings) == 0 {
+		t.Error("readings of the port do not belong to the active plant")
+	}
+}
@@ -1,32 +1,44 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/ZamarianPatrick/lazypig-backend/auth"
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
+	"github.com/ZamarianPatrick/lazypig-backend/config"
 	"github.com/ZamarianPatrick/lazypig-backend/graph"
 	"github.com/ZamarianPatrick/lazypig-backend/graph/generated"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"log"
 	"net/http"
+	"net/url"
+	"os"
+	"strings"
 	"time"
 )
 
 var VERSION string = "dev"
 
-func graphqlHandler(resolver *graph.Resolver) gin.HandlerFunc {
-	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))
+func graphqlHandler(resolver *graph.Resolver, origins []string) gin.HandlerFunc {
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  resolver,
+		Directives: resolver.Directives(),
+	}))
 	srv.AddTransport(&transport.Websocket{
 		Upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
-				return true
+				return originAllowed(origins, r)
 			},
 			ReadBufferSize:  1024,
 			WriteBufferSize: 1024,
 		},
+		InitFunc:              resolver.WebsocketInit,
 		KeepAlivePingInterval: 15 * time.Second,
 	})
 
@@ -36,11 +48,13 @@ func graphqlHandler(resolver *graph.Resolver) gin.HandlerFunc {
 	srv.AddTransport(transport.MultipartForm{})
 
 	srv.SetQueryCache(lru.New(1000))
+	srv.SetErrorPresenter(graph.ErrorPresenter)
 
 	srv.Use(extension.Introspection{})
 	srv.Use(extension.AutomaticPersistedQuery{
 		Cache: lru.New(100),
 	})
+	srv.AroundFields(resolver.RequireAuthentication)
 
 	return func(c *gin.Context) {
 		srv.ServeHTTP(c.Writer, c.Request)
@@ -55,12 +69,61 @@ func playgroundHandler() gin.HandlerFunc {
 	}
 }
 
-func corsMiddleware() gin.HandlerFunc {
+// authMiddleware authenticates requests with an Authorization header, requests without one go on unauthenticated
+// and can only use the public fields.
+func authMiddleware(resolver *graph.Resolver) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
-		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT")
+		token := auth.BearerToken(c.GetHeader("Authorization"))
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		ctx, err := resolver.Authenticate(c.Request.Context(), token)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"errors": []gin.H{{
+					"message":    err.Error(),
+					"extensions": gin.H{"code": graph.ErrorCodeUnauthenticated},
+				}},
+			})
+			return
+		}
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// originAllowed reports whether a browser may send the request, requests without origin do not come from a browser.
+func originAllowed(origins []string, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	if u, err := url.Parse(origin); err == nil && u.Host == r.Host {
+		return true
+	}
+
+	for _, allowed := range origins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func corsMiddleware(origins []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Origin")
+
+		if origin := c.GetHeader("Origin"); origin != "" && originAllowed(origins, c.Request) {
+			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+			c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+			c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+			c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT")
+		}
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -77,16 +140,47 @@ func Pong(c *gin.Context) {
 
 func main() {
 
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	parsed, err := cfg.Parse()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// nil runs the backend on the system clock
+	var backendClock clock.Clock
+	if parsed.ClockSpeed != 1 {
+		log.Printf("clock runs %g times faster than real time", parsed.ClockSpeed)
+		backendClock = clock.NewAccelerated(time.Now(), parsed.ClockSpeed)
+	}
+
 	r := gin.Default()
-	resolver, err := graph.NewResolver(VERSION)
+	resolver, err := graph.NewResolver(VERSION, graph.Options{
+		FakeValues:       cfg.FakeHardware(),
+		SettingsPath:     cfg.SettingsPath(),
+		DBPath:           cfg.DBPath(),
+		LogLevel:         parsed.LogLevel,
+		SessionLifetime:  parsed.SessionLifetime,
+		Notifiers:        parsed.Notifiers,
+		RenotifyInterval: parsed.RenotifyInterval,
+		AlertKinds:       parsed.AlertKinds,
+		MQTT:             cfg.MQTT,
+		Clock:            backendClock,
+	})
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	r.Use(corsMiddleware())
-	r.Any("/graphql", graphqlHandler(resolver))
+	r.Use(corsMiddleware(cfg.Origins()))
+	r.Any("/graphql", authMiddleware(resolver), graphqlHandler(resolver, cfg.Origins()))
 	r.GET("/ping", Pong)
 	r.GET("/", playgroundHandler())
-	r.Run(":8080")
+	r.Run(cfg.Listen)
 
 }
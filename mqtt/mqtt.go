@@ -0,0 +1,212 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"log"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// StatusOnline and StatusOffline are published retained to the status topic, StatusOffline is the last will
+	// so the broker publishes it once the backend is gone without saying goodbye.
+	StatusOnline  = "online"
+	StatusOffline = "offline"
+
+	// PublishTimeout is how long a single publish may wait for the broker.
+	PublishTimeout = 10 * time.Second
+)
+
+// Config connects the backend to a MQTT broker, without a broker the bridge is disabled.
+type Config struct {
+	// Broker is the url of the broker like tcp://localhost:1883, ssl://broker:8883 or ws://broker:9001/mqtt
+	Broker   string `yaml:"broker"`
+	ClientID string `yaml:"clientID"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// TopicPrefix is put in front of every topic, defaults to lazypig
+	TopicPrefix string `yaml:"topicPrefix"`
+	// QoS is the quality of service of every publish and subscription, 0, 1 or 2
+	QoS byte `yaml:"qos"`
+	// ReadOnly only publishes and does not subscribe to the command topics.
+	// Without it anybody who may publish to the command topics can water the plants, so protect them with the acl of the broker.
+	ReadOnly bool `yaml:"readOnly"`
+}
+
+func (c Config) Enabled() bool {
+	return c.Broker != ""
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	u, err := url.Parse(c.Broker)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("mqtt broker must be an url like tcp://localhost:1883, not %s", c.Broker)
+	}
+	switch u.Scheme {
+	case "tcp", "mqtt", "ssl", "tls", "mqtts", "ws", "wss":
+	default:
+		return fmt.Errorf("mqtt broker scheme must be tcp, ssl, ws or wss, not %s", u.Scheme)
+	}
+
+	if c.QoS > 2 {
+		return fmt.Errorf("mqtt qos must be 0, 1 or 2, not %d", c.QoS)
+	}
+	if strings.ContainsAny(c.TopicPrefix, "+#") {
+		return fmt.Errorf("mqtt topic prefix must not contain wildcards, not %s", c.TopicPrefix)
+	}
+
+	return nil
+}
+
+func (c Config) withDefaults() Config {
+	if c.ClientID == "" {
+		c.ClientID = "lazypig"
+	}
+	if c.TopicPrefix == "" {
+		c.TopicPrefix = "lazypig"
+	}
+	c.TopicPrefix = strings.Trim(c.TopicPrefix, "/")
+	return c
+}
+
+// ErrNotConnected is returned by Publish while the broker is gone. Retained state has to be published again
+// by the onConnect callback of Connect.
+var ErrNotConnected = errors.New("mqtt is not connected")
+
+// Handler handles a message of a subscribed topic, handlers run concurrently.
+type Handler func(topic string, payload []byte)
+
+// Client publishes to and subscribes at the broker. It reconnects on its own and subscribes again after every reconnect,
+// messages which are published while the broker is gone fail right away.
+type Client struct {
+	config    Config
+	client    paho.Client
+	onConnect func()
+
+	mutex         sync.Mutex
+	subscriptions map[string]Handler
+}
+
+// Connect starts connecting to the broker and returns right away, the backend must not wait for the broker to start.
+// onConnect is called after every connect, once the status is online and the subscriptions are back.
+func Connect(config Config, onConnect func()) (*Client, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if !config.Enabled() {
+		return nil, errors.New("mqtt needs a broker")
+	}
+
+	c := &Client{
+		config:        config.withDefaults(),
+		onConnect:     onConnect,
+		subscriptions: make(map[string]Handler),
+	}
+
+	options := paho.NewClientOptions().
+		AddBroker(c.config.Broker).
+		SetClientID(c.config.ClientID).
+		SetUsername(c.config.Username).
+		SetPassword(c.config.Password).
+		SetCleanSession(true).
+		SetOrderMatters(false).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetMaxReconnectInterval(time.Minute).
+		SetWill(c.Topic("status"), StatusOffline, c.config.QoS, true).
+		SetOnConnectHandler(c.connected).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			log.Println("mqtt connection lost, reconnecting:", err)
+		})
+
+	c.client = paho.NewClient(options)
+	c.client.Connect()
+
+	return c, nil
+}
+
+func (c *Client) connected(paho.Client) {
+	log.Println("mqtt connected to", c.config.Broker)
+
+	c.client.Publish(c.Topic("status"), c.config.QoS, true, StatusOnline)
+
+	c.mutex.Lock()
+	for topic, handler := range c.subscriptions {
+		c.subscribe(topic, handler)
+	}
+	c.mutex.Unlock()
+
+	if c.onConnect != nil {
+		c.onConnect()
+	}
+}
+
+// Topic joins the parts to a topic below the prefix.
+func (c *Client) Topic(parts ...string) string {
+	return strings.Join(append([]string{c.config.TopicPrefix}, parts...), "/")
+}
+
+// ReadOnly reports whether the client must not subscribe to command topics.
+func (c *Client) ReadOnly() bool {
+	return c.config.ReadOnly
+}
+
+// Publish sends payload to topic, strings and byte slices as they are and everything else as JSON.
+// It waits for the broker at most PublishTimeout and fails with ErrNotConnected while there is no connection,
+// with a clean session paho would never send the messages it keeps until the connection is back.
+func (c *Client) Publish(topic string, retained bool, payload interface{}) error {
+	if !c.client.IsConnectionOpen() {
+		return ErrNotConnected
+	}
+
+	var data []byte
+	switch p := payload.(type) {
+	case []byte:
+		data = p
+	case string:
+		data = []byte(p)
+	default:
+		var err error
+		if data, err = json.Marshal(p); err != nil {
+			return err
+		}
+	}
+
+	token := c.client.Publish(topic, c.config.QoS, retained, data)
+	if !token.WaitTimeout(PublishTimeout) {
+		return fmt.Errorf("mqtt publish to %s timed out", topic)
+	}
+	return token.Error()
+}
+
+// Subscribe handles the messages of topic, which may contain wildcards, now and after every reconnect.
+func (c *Client) Subscribe(topic string, handler Handler) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.subscriptions[topic] = handler
+	if c.client.IsConnectionOpen() {
+		c.subscribe(topic, handler)
+	}
+}
+
+func (c *Client) subscribe(topic string, handler Handler) {
+	token := c.client.Subscribe(topic, c.config.QoS, func(_ paho.Client, m paho.Message) {
+		handler(m.Topic(), m.Payload())
+	})
+
+	go func() {
+		if token.WaitTimeout(PublishTimeout) && token.Error() != nil {
+			log.Println("could not subscribe to mqtt topic", topic, token.Error())
+		}
+	}()
+}
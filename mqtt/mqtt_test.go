@@ -0,0 +1,147 @@
+package mqtt
+
+import (
+	"github.com/ZamarianPatrick/lazypig-backend/mqtt/mqtttest"
+	"testing"
+	"time"
+)
+
+const testTimeout = 5 * time.Second
+
+func newTestBroker(t *testing.T) *mqtttest.Broker {
+	t.Helper()
+
+	broker, err := mqtttest.NewBroker()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		broker.Close()
+	})
+	return broker
+}
+
+func TestConfigValidate(t *testing.T) {
+	for _, c := range []struct {
+		config Config
+		valid  bool
+	}{
+		{Config{}, true},
+		{Config{Broker: "tcp://localhost:1883"}, true},
+		{Config{Broker: "wss://broker:9001/mqtt", QoS: 2, TopicPrefix: "home/garden"}, true},
+		{Config{Broker: "localhost:1883"}, false},
+		{Config{Broker: "http://localhost:1883"}, false},
+		{Config{Broker: "tcp://localhost:1883", QoS: 3}, false},
+		{Config{Broker: "tcp://localhost:1883", TopicPrefix: "home/+"}, false},
+	} {
+		if err := c.config.Validate(); (err == nil) != c.valid {
+			t.Errorf("validating %+v returned %v, want valid: %v", c.config, err, c.valid)
+		}
+	}
+}
+
+func TestClientPublishesAndSubscribes(t *testing.T) {
+	broker := newTestBroker(t)
+
+	connected := make(chan struct{}, 1)
+	client, err := Connect(Config{Broker: broker.URL(), TopicPrefix: "/garden/", QoS: 1}, func() {
+		connected <- struct{}{}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-connected:
+	case <-time.After(testTimeout):
+		t.Fatal("client did not connect")
+	}
+	if !broker.WaitRetained("garden/status", StatusOnline, testTimeout) {
+		t.Error("status is not online")
+	}
+
+	if err = client.Publish(client.Topic("plant", "1"), true, map[string]int{"id": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if payload, _ := broker.Retained("garden/plant/1"); string(payload) != `{"id":1}` {
+		t.Errorf("got %s, want the payload as JSON", payload)
+	}
+
+	received := make(chan string, 1)
+	client.Subscribe(client.Topic("plant", "+", "set"), func(topic string, payload []byte) {
+		received <- topic + " " + string(payload)
+	})
+	if !broker.Wait(testTimeout, func() bool { return broker.Subscribed("garden/plant/+/set") == 1 }) {
+		t.Fatal("client did not subscribe")
+	}
+
+	broker.Publish("garden/plant/2/set", []byte("on"), false)
+	select {
+	case message := <-received:
+		if message != "garden/plant/2/set on" {
+			t.Errorf("handler got %q", message)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("handler got no message")
+	}
+}
+
+func TestPublishFailsRightAwayWithoutConnection(t *testing.T) {
+	broker := newTestBroker(t)
+	url := broker.URL()
+	broker.Close()
+
+	client, err := Connect(Config{Broker: url}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if err = client.Publish(client.Topic("status"), true, StatusOnline); err != ErrNotConnected {
+		t.Errorf("publish without connection returned %v, want %v", err, ErrNotConnected)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("publish without connection took %s", d)
+	}
+}
+
+func TestClientSubscribesAgainAfterAReconnect(t *testing.T) {
+	broker := newTestBroker(t)
+
+	connected := make(chan struct{}, 2)
+	client, err := Connect(Config{Broker: broker.URL()}, func() {
+		connected <- struct{}{}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Subscribe(client.Topic("command"), func(string, []byte) {})
+
+	<-connected
+	if !broker.Wait(testTimeout, func() bool { return broker.Subscribed("lazypig/command") == 1 }) {
+		t.Fatal("client did not subscribe")
+	}
+
+	broker.Disconnect()
+
+	select {
+	case <-connected:
+	case <-time.After(testTimeout):
+		t.Fatal("client did not reconnect")
+	}
+	if !broker.Wait(testTimeout, func() bool { return broker.Subscribed("lazypig/command") == 2 }) {
+		t.Error("client did not subscribe again")
+	}
+
+	var statuses []string
+	broker.Wait(testTimeout, func() bool {
+		statuses = nil
+		for _, m := range broker.Messages("lazypig/status") {
+			statuses = append(statuses, string(m.Payload))
+		}
+		return len(statuses) == 3
+	})
+	if len(statuses) != 3 || statuses[0] != StatusOnline || statuses[1] != StatusOffline || statuses[2] != StatusOnline {
+		t.Errorf("got statuses %v, want online, the last will and online again", statuses)
+	}
+}
@@ -0,0 +1,265 @@
+// Package mqtttest runs an embedded MQTT broker on a local port for tests and records what the clients send to it.
+package mqtttest
+
+import (
+	"github.com/mochi-co/mqtt/server"
+	"github.com/mochi-co/mqtt/server/events"
+	"github.com/mochi-co/mqtt/server/listeners"
+	"github.com/mochi-co/mqtt/server/listeners/auth"
+	"github.com/mochi-co/mqtt/server/system"
+	"net"
+	"sync"
+	"time"
+)
+
+// Message is a message published to the broker, by a client or by the test.
+type Message struct {
+	Topic    string
+	Payload  []byte
+	Retained bool
+}
+
+// Broker keeps every published message, so tests can check what the clients sent.
+type Broker struct {
+	server    *server.Server
+	listener  *listener
+	closeOnce sync.Once
+
+	mutex         sync.Mutex
+	changed       *sync.Cond
+	messages      []Message
+	subscriptions []string
+	// version counts the changes, so Wait does not miss a change while it checks
+	version int
+}
+
+// NewBroker listens on a free local port, it runs until it is closed.
+func NewBroker() (*Broker, error) {
+	netListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Broker{
+		server: server.NewServer(nil),
+		listener: &listener{
+			listener: netListener,
+			conns:    make(map[net.Conn]bool),
+		},
+	}
+	b.changed = sync.NewCond(&b.mutex)
+
+	b.server.Events.OnMessage = func(_ events.Client, pk events.Packet) (events.Packet, error) {
+		b.record(Message{Topic: pk.TopicName, Payload: pk.Payload, Retained: pk.FixedHeader.Retain})
+		return pk, nil
+	}
+	b.server.Events.OnSubscribe = func(filter string, _ events.Client, _ byte) {
+		b.mutex.Lock()
+		b.subscriptions = append(b.subscriptions, filter)
+		b.changeLocked()
+		b.mutex.Unlock()
+	}
+	b.server.Events.OnDisconnect = func(events.Client, error) {
+		b.mutex.Lock()
+		b.changeLocked()
+		b.mutex.Unlock()
+	}
+
+	if err = b.server.AddListener(b.listener, nil); err != nil {
+		netListener.Close()
+		return nil, err
+	}
+	if err = b.server.Serve(); err != nil {
+		b.server.Close()
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// URL is the url clients connect to, like tcp://127.0.0.1:41234.
+func (b *Broker) URL() string {
+	return "tcp://" + b.listener.listener.Addr().String()
+}
+
+// Close stops listening and drops all connections, closing it again does nothing.
+func (b *Broker) Close() error {
+	var err error
+	b.closeOnce.Do(func() {
+		err = b.server.Close()
+	})
+	return err
+}
+
+// Disconnect drops all connections like a broker restart would, the last wills of the clients are published.
+func (b *Broker) Disconnect() {
+	b.listener.disconnect()
+}
+
+// Publish publishes a message like a client would.
+func (b *Broker) Publish(topic string, payload []byte, retained bool) {
+	b.server.Publish(topic, payload, retained)
+	b.record(Message{Topic: topic, Payload: payload, Retained: retained})
+}
+
+// Retained returns the retained message of topic.
+func (b *Broker) Retained(topic string) ([]byte, bool) {
+	for _, pk := range b.server.Topics.Messages(topic) {
+		if pk.TopicName == topic {
+			return pk.Payload, true
+		}
+	}
+	return nil, false
+}
+
+// Messages returns the messages published to topic in the order they were published.
+func (b *Broker) Messages(topic string) []Message {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	messages := make([]Message, 0)
+	for _, m := range b.messages {
+		if m.Topic == topic {
+			messages = append(messages, m)
+		}
+	}
+	return messages
+}
+
+// Subscribed reports how often clients subscribed to filter.
+func (b *Broker) Subscribed(filter string) int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	n := 0
+	for _, f := range b.subscriptions {
+		if f == filter {
+			n++
+		}
+	}
+	return n
+}
+
+// Wait waits until done reports true or timeout passed, done is checked again after every change of the broker.
+func (b *Broker) Wait(timeout time.Duration, done func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	timer := time.AfterFunc(timeout, func() {
+		b.mutex.Lock()
+		b.changed.Broadcast()
+		b.mutex.Unlock()
+	})
+	defer timer.Stop()
+
+	for {
+		b.mutex.Lock()
+		version := b.version
+		b.mutex.Unlock()
+
+		if done() {
+			return true
+		}
+
+		b.mutex.Lock()
+		if !time.Now().Before(deadline) {
+			b.mutex.Unlock()
+			return false
+		}
+		if version == b.version {
+			b.changed.Wait()
+		}
+		b.mutex.Unlock()
+	}
+}
+
+// WaitRetained waits until payload is retained for topic, an empty payload waits until nothing is retained.
+func (b *Broker) WaitRetained(topic string, payload string, timeout time.Duration) bool {
+	return b.Wait(timeout, func() bool {
+		retained, ok := b.Retained(topic)
+		if payload == "" {
+			return !ok
+		}
+		return ok && string(retained) == payload
+	})
+}
+
+// WaitMessage waits until a message was published to topic and returns the first one.
+func (b *Broker) WaitMessage(topic string, timeout time.Duration) (Message, bool) {
+	var message Message
+	ok := b.Wait(timeout, func() bool {
+		messages := b.Messages(topic)
+		if len(messages) == 0 {
+			return false
+		}
+		message = messages[0]
+		return true
+	})
+	return message, ok
+}
+
+// record keeps a published message, retained messages are kept by the server before.
+func (b *Broker) record(m Message) {
+	b.mutex.Lock()
+	b.messages = append(b.messages, m)
+	b.changeLocked()
+	b.mutex.Unlock()
+}
+
+// changeLocked wakes up the waiters, the mutex has to be held.
+func (b *Broker) changeLocked() {
+	b.version++
+	b.changed.Broadcast()
+}
+
+// listener hands the connections of a local port to the server and keeps them, so they can be dropped
+// like a network failure would. Clients stopped by the server itself do not always send their last will.
+type listener struct {
+	listener net.Listener
+
+	mutex sync.Mutex
+	conns map[net.Conn]bool
+}
+
+func (l *listener) SetConfig(*listeners.Config) {}
+
+func (l *listener) Listen(*system.Info) error {
+	return nil
+}
+
+func (l *listener) Serve(establish listeners.EstablishFunc) {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		l.mutex.Lock()
+		l.conns[conn] = true
+		l.mutex.Unlock()
+
+		go func() {
+			establish(l.ID(), conn, new(auth.Allow))
+
+			l.mutex.Lock()
+			delete(l.conns, conn)
+			l.mutex.Unlock()
+		}()
+	}
+}
+
+func (l *listener) ID() string {
+	return "mqtttest"
+}
+
+func (l *listener) Close(closeClients listeners.CloseFunc) {
+	l.listener.Close()
+	closeClients(l.ID())
+}
+
+func (l *listener) disconnect() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for conn := range l.conns {
+		conn.Close()
+	}
+}
@@ -0,0 +1,75 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Notification is an alert as it is sent to people.
+type Notification struct {
+	AlertID   uint64    `json:"alertID"`
+	StationID uint64    `json:"stationID"`
+	Port      string    `json:"port,omitempty"`
+	Kind      string    `json:"kind"`
+	Title     string    `json:"title"`
+	Message   string    `json:"message"`
+	Resolved  bool      `json:"resolved"`
+	Time      time.Time `json:"time"`
+}
+
+// Notifier sends notifications somewhere, like to a webhook, a mail address or a push service.
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Timeout is how long a notifier may take to send a single notification.
+const Timeout = 30 * time.Second
+
+// post sends body to url and fails for every status but 2xx.
+func post(ctx context.Context, url string, contentType string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		text, _ := ioutil.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("%s answered %s: %s", url, res.Status, bytes.TrimSpace(text))
+	}
+	return nil
+}
+
+func postJSON(ctx context.Context, url string, payload interface{}, headers map[string]string) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return post(ctx, url, "application/json", body, headers)
+}
+
+// headerValue keeps a value in its header line, of a mail or of a HTTP request. The title of a notification contains
+// the station name anybody with the role to edit stations can choose.
+func headerValue(value string) string {
+	return strings.Join(strings.FieldsFunc(value, func(r rune) bool {
+		return r == '\r' || r == '\n'
+	}), " ")
+}
@@ -0,0 +1,86 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"strings"
+)
+
+// NtfyConfig publishes notifications to a ntfy topic, URL is the server with the topic like https://ntfy.sh/my-plants.
+type NtfyConfig struct {
+	URL string `yaml:"url"`
+	// Token is an access token for protected topics
+	Token string `yaml:"token"`
+}
+
+type ntfy struct {
+	config NtfyConfig
+}
+
+func NewNtfy(config NtfyConfig) (Notifier, error) {
+	if config.URL == "" {
+		return nil, errors.New("ntfy needs the url of a topic")
+	}
+
+	return &ntfy{config: config}, nil
+}
+
+func (p *ntfy) Name() string {
+	return "ntfy " + p.config.URL
+}
+
+func (p *ntfy) Notify(ctx context.Context, n Notification) error {
+	// ntfy decodes titles in the encoding of mail headers, so titles with umlauts or emojis arrive as they are
+	headers := map[string]string{
+		"Title":    mime.QEncoding.Encode("utf-8", headerValue(n.Title)),
+		"Priority": "high",
+		"Tags":     "potted_plant",
+	}
+	if n.Resolved {
+		headers["Priority"] = "default"
+		headers["Tags"] = "white_check_mark"
+	}
+	if p.config.Token != "" {
+		headers["Authorization"] = "Bearer " + p.config.Token
+	}
+
+	return post(ctx, p.config.URL, "text/plain", []byte(n.Message), headers)
+}
+
+// GotifyConfig sends notifications to a Gotify server, Token is the token of the application.
+type GotifyConfig struct {
+	URL   string `yaml:"url"`
+	Token string `yaml:"token"`
+}
+
+type gotify struct {
+	config GotifyConfig
+}
+
+func NewGotify(config GotifyConfig) (Notifier, error) {
+	if config.URL == "" || config.Token == "" {
+		return nil, errors.New("gotify needs the url of the server and an application token")
+	}
+
+	return &gotify{config: config}, nil
+}
+
+func (p *gotify) Name() string {
+	return "gotify " + p.config.URL
+}
+
+func (p *gotify) Notify(ctx context.Context, n Notification) error {
+	priority := 8
+	if n.Resolved {
+		priority = 4
+	}
+
+	return postJSON(ctx, strings.TrimSuffix(p.config.URL, "/")+"/message", map[string]interface{}{
+		"title":    n.Title,
+		"message":  n.Message,
+		"priority": priority,
+	}, map[string]string{
+		"X-Gotify-Key": p.config.Token,
+	})
+}
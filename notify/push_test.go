@@ -0,0 +1,116 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"testing"
+)
+
+func TestNtfyKeepsTheTitleInItsHeader(t *testing.T) {
+	server, requests := newServer(t, http.StatusOK)
+	n, err := NewNtfy(NtfyConfig{URL: server.URL + "/my-plants", Token: "tk_secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = n.Notify(context.Background(), testNotification); err != nil {
+		t.Fatal(err)
+	}
+
+	r := <-requests
+	if r.path != "/my-plants" || string(r.body) != testNotification.Message {
+		t.Errorf("got %q posted to %s, want the message posted to the topic", r.body, r.path)
+	}
+
+	title, err := new(mime.WordDecoder).DecodeHeader(r.header.Get("Title"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if title != "Balkon ☘ port A: sensor fault" {
+		t.Errorf("got title %q, want the title on a single line", title)
+	}
+	for name, want := range map[string]string{
+		"Priority":      "high",
+		"Tags":          "potted_plant",
+		"Authorization": "Bearer tk_secret",
+		"Content-Type":  "text/plain",
+	} {
+		if got := r.header.Get(name); got != want {
+			t.Errorf("got %s %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestNtfyLowersThePriorityOfResolvedAlerts(t *testing.T) {
+	server, requests := newServer(t, http.StatusOK)
+	n, err := NewNtfy(NtfyConfig{URL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolved := testNotification
+	resolved.Title = "Resolved: sensor fault"
+	resolved.Resolved = true
+	if err = n.Notify(context.Background(), resolved); err != nil {
+		t.Fatal(err)
+	}
+
+	r := <-requests
+	if r.header.Get("Title") != "Resolved: sensor fault" || r.header.Get("Priority") != "default" || r.header.Get("Tags") != "white_check_mark" {
+		t.Errorf("got headers %v, want a resolved notification", r.header)
+	}
+	if r.header.Get("Authorization") != "" {
+		t.Error("notification without token was sent with an authorization")
+	}
+}
+
+func TestGotifyPostsTheMessage(t *testing.T) {
+	server, requests := newServer(t, http.StatusOK)
+	g, err := NewGotify(GotifyConfig{URL: server.URL + "/", Token: "app-token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, resolved := range []bool{false, true} {
+		n := testNotification
+		n.Resolved = resolved
+		if err = g.Notify(context.Background(), n); err != nil {
+			t.Fatal(err)
+		}
+
+		r := <-requests
+		if r.path != "/message" || r.header.Get("X-Gotify-Key") != "app-token" || r.header.Get("Content-Type") != "application/json" {
+			t.Errorf("got request to %s with headers %v", r.path, r.header)
+		}
+
+		var message struct {
+			Title    string `json:"title"`
+			Message  string `json:"message"`
+			Priority int    `json:"priority"`
+		}
+		if err = json.Unmarshal(r.body, &message); err != nil {
+			t.Fatal(err)
+		}
+		want := 8
+		if resolved {
+			want = 4
+		}
+		if message.Title != n.Title || message.Message != n.Message || message.Priority != want {
+			t.Errorf("got %+v, want the notification with priority %d", message, want)
+		}
+	}
+}
+
+func TestPushNotifiersNeedTheirConfig(t *testing.T) {
+	if _, err := NewNtfy(NtfyConfig{}); err == nil {
+		t.Error("ntfy without url was created")
+	}
+	if _, err := NewGotify(GotifyConfig{URL: "https://gotify.example.com"}); err == nil {
+		t.Error("gotify without token was created")
+	}
+	if _, err := NewWebhook(WebhookConfig{}); err == nil {
+		t.Error("webhook without url was created")
+	}
+}
@@ -0,0 +1,122 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTPConfig mails notifications. Without TLS the connection is upgraded with STARTTLS if the server offers it,
+// with TLS the connection is encrypted right away like on port 465.
+type SMTPConfig struct {
+	Host     string   `yaml:"host"`
+	Port     int      `yaml:"port"`
+	Username string   `yaml:"username"`
+	Password string   `yaml:"password"`
+	TLS      bool     `yaml:"tls"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+}
+
+type mail struct {
+	config SMTPConfig
+}
+
+func NewSMTP(config SMTPConfig) (Notifier, error) {
+	if config.Host == "" || config.From == "" || len(config.To) == 0 {
+		return nil, errors.New("smtp needs a host, a from and at least one to address")
+	}
+	if config.Port == 0 {
+		config.Port = 587
+		if config.TLS {
+			config.Port = 465
+		}
+	}
+
+	return &mail{config: config}, nil
+}
+
+func (m *mail) Name() string {
+	return "smtp " + m.config.Host
+}
+
+func (m *mail) Notify(ctx context.Context, n Notification) error {
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	tlsConfig := &tls.Config{ServerName: m.config.Host}
+	if m.config.TLS {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok && !m.config.TLS {
+		if err = client.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+
+	if m.config.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err = client.Mail(m.config.From); err != nil {
+		return err
+	}
+	for _, to := range m.config.To {
+		if err = client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(m.message(n)); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (m *mail) message(n Notification) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", headerValue(m.config.From))
+	fmt.Fprintf(&b, "To: %s\r\n", headerValue(strings.Join(m.config.To, ", ")))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", headerValue(n.Title)))
+	fmt.Fprintf(&b, "Date: %s\r\n", n.Time.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(n.Message, "\r\n", "\n"), "\n", "\r\n"))
+	b.WriteString("\r\n")
+
+	return []byte(b.String())
+}
@@ -0,0 +1,30 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMailMessageKeepsTheTitleInTheSubject(t *testing.T) {
+	m := &mail{config: SMTPConfig{From: "lazypig@example.com", To: []string{"ann@example.com"}}}
+
+	message := string(m.message(Notification{
+		Title:   "Balkon ☘\r\nBcc: eve@example.com: sensor fault",
+		Message: "moisture sensor is faulted",
+		Time:    time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC),
+	}))
+
+	headers := strings.SplitN(message, "\r\n\r\n", 2)[0]
+	if !strings.Contains(headers, "\r\nSubject: ") {
+		t.Fatalf("no subject in %q", headers)
+	}
+	for _, line := range strings.Split(headers, "\r\n") {
+		if strings.HasPrefix(line, "Bcc:") {
+			t.Fatalf("the title injected the header %q", line)
+		}
+		if strings.HasPrefix(line, "Subject:") && line != "Subject: =?utf-8?q?Balkon_=E2=98=98_Bcc:_eve@example.com:_sensor_fault?=" {
+			t.Errorf("got %q, want an encoded subject on a single line", line)
+		}
+	}
+}
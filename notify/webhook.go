@@ -0,0 +1,33 @@
+package notify
+
+import (
+	"context"
+	"errors"
+)
+
+// WebhookConfig posts every notification as JSON to URL.
+type WebhookConfig struct {
+	URL string `yaml:"url"`
+	// Headers are sent with every request, like an Authorization header
+	Headers map[string]string `yaml:"headers"`
+}
+
+type webhook struct {
+	config WebhookConfig
+}
+
+func NewWebhook(config WebhookConfig) (Notifier, error) {
+	if config.URL == "" {
+		return nil, errors.New("a webhook needs an url")
+	}
+
+	return &webhook{config: config}, nil
+}
+
+func (w *webhook) Name() string {
+	return "webhook " + w.config.URL
+}
+
+func (w *webhook) Notify(ctx context.Context, n Notification) error {
+	return postJSON(ctx, w.config.URL, n, w.config.Headers)
+}
@@ -0,0 +1,86 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// request is a request the test server got.
+type request struct {
+	path   string
+	header http.Header
+	body   []byte
+}
+
+// newServer records the requests it gets and answers them with status.
+func newServer(t *testing.T, status int) (*httptest.Server, chan request) {
+	t.Helper()
+
+	requests := make(chan request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- request{path: r.URL.Path, header: r.Header, body: body}
+		w.WriteHeader(status)
+		w.Write([]byte("answer"))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+var testNotification = Notification{
+	AlertID:   7,
+	StationID: 1,
+	Port:      "A",
+	Kind:      "SENSOR_FAULT",
+	Title:     "Balkon ☘\r\nport A: sensor fault",
+	Message:   "moisture sensor is faulted",
+	Time:      time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC),
+}
+
+func TestWebhookPostsTheNotification(t *testing.T) {
+	server, requests := newServer(t, http.StatusNoContent)
+	w, err := NewWebhook(WebhookConfig{URL: server.URL + "/hook", Headers: map[string]string{"Authorization": "Bearer secret"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = w.Notify(context.Background(), testNotification); err != nil {
+		t.Fatal(err)
+	}
+
+	r := <-requests
+	if r.path != "/hook" || r.header.Get("Content-Type") != "application/json" || r.header.Get("Authorization") != "Bearer secret" {
+		t.Errorf("got request to %s with headers %v", r.path, r.header)
+	}
+
+	var got Notification
+	if err = json.Unmarshal(r.body, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != testNotification {
+		t.Errorf("got %+v, want %+v", got, testNotification)
+	}
+}
+
+func TestNotifyFailsForErrorStatus(t *testing.T) {
+	server, requests := newServer(t, http.StatusUnauthorized)
+	w, err := NewWebhook(WebhookConfig{URL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.Notify(context.Background(), testNotification)
+	<-requests
+	if err == nil {
+		t.Fatal("notify succeeded although the server answered 401")
+	}
+	if want := server.URL + " answered 401 Unauthorized: answer"; err.Error() != want {
+		t.Errorf("got error %q, want %q", err, want)
+	}
+}
@@ -0,0 +1,112 @@
+package sensors
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+type CalibrationPoint struct {
+	Raw        uint16  `yaml:"raw"`
+	Percentage float64 `yaml:"percentage"`
+}
+
+// Calibration maps raw moisture sensor values to percentages.
+// Dry is the raw value of dry soil (0%) and Wet the one of soaked soil (100%), values in between are interpolated linearly.
+// Points, if given, take precedence over Dry and Wet and describe a curve of at least two points.
+type Calibration struct {
+	Dry    uint16             `yaml:"dry"`
+	Wet    uint16             `yaml:"wet"`
+	Points []CalibrationPoint `yaml:"points,omitempty"`
+}
+
+var DefaultCalibration = Calibration{
+	Dry: 2000,
+	Wet: 1000,
+}
+
+func (c Calibration) Validate() error {
+	if len(c.Points) > 0 {
+		if len(c.Points) < 2 {
+			return errors.New("a calibration curve needs at least two points")
+		}
+
+		raws := make(map[uint16]bool)
+		for _, p := range c.Points {
+			if p.Percentage < 0 || p.Percentage > 100 {
+				return fmt.Errorf("percentage %.1f of raw value %d is not between 0 and 100", p.Percentage, p.Raw)
+			}
+			if raws[p.Raw] {
+				return fmt.Errorf("raw value %d is used more than once", p.Raw)
+			}
+			raws[p.Raw] = true
+		}
+
+		return nil
+	}
+
+	if c.Dry == c.Wet {
+		return fmt.Errorf("dry and wet raw value are both %d", c.Dry)
+	}
+
+	return nil
+}
+
+func (c Calibration) Percentage(raw uint16) float64 {
+	if len(c.Points) >= 2 {
+		return c.curvePercentage(raw)
+	}
+
+	return interpolate(float64(raw), float64(c.Dry), 0, float64(c.Wet), 100)
+}
+
+// Raw is the inverse of Percentage for linear calibrations, curves are ignored.
+func (c Calibration) Raw(percentage float64) uint16 {
+	if percentage < 0 {
+		percentage = 0
+	}
+	if percentage > 100 {
+		percentage = 100
+	}
+
+	raw := float64(c.Dry) + (float64(c.Wet)-float64(c.Dry))*percentage/100
+	return uint16(raw + 0.5)
+}
+
+func (c Calibration) curvePercentage(raw uint16) float64 {
+	points := make([]CalibrationPoint, len(c.Points))
+	copy(points, c.Points)
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Raw < points[j].Raw
+	})
+
+	if raw <= points[0].Raw {
+		return points[0].Percentage
+	}
+
+	for i := 1; i < len(points); i++ {
+		if raw <= points[i].Raw {
+			a, b := points[i-1], points[i]
+			return interpolate(float64(raw), float64(a.Raw), a.Percentage, float64(b.Raw), b.Percentage)
+		}
+	}
+
+	return points[len(points)-1].Percentage
+}
+
+// interpolate maps x linearly from the range x1..x2 to y1..y2 and clamps it to that range.
+func interpolate(x, x1, y1, x2, y2 float64) float64 {
+	if x1 == x2 {
+		return y1
+	}
+
+	t := (x - x1) / (x2 - x1)
+	if t < 0 {
+		t = 0
+	}
+	if t > 1 {
+		t = 1
+	}
+
+	return y1 + t*(y2-y1)
+}
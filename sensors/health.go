@@ -0,0 +1,117 @@
+package sensors
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Fault tells why the values of a sensor can not be trusted, the values match the SensorFault enum of the API.
+type Fault string
+
+const (
+	FaultNone Fault = ""
+	// FaultReadErrors means the sensor could not be read several times in a row, like a disconnected probe
+	FaultReadErrors Fault = "READ_ERRORS"
+	// FaultOutOfRange means the sensor delivered raw values no working sensor delivers several times in a row
+	FaultOutOfRange Fault = "OUT_OF_RANGE"
+	// FaultStuck means the raw values of the sensor did not change at all for a long time
+	FaultStuck Fault = "STUCK"
+)
+
+// OutOfRangeError is returned by sensors which could be read but whose raw value is implausible.
+type OutOfRangeError struct {
+	Raw int `json:"raw"`
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+func (e *OutOfRangeError) Error() string {
+	return fmt.Sprintf("raw value %d is not between %d and %d", e.Raw, e.Min, e.Max)
+}
+
+// Health is the state of a single sensor of a station. It is only changed by the loop of the station.
+type Health struct {
+	SensorName          string
+	Port                string
+	LastSuccess         time.Time
+	LastFailure         time.Time
+	LastError           string
+	ConsecutiveFailures int
+	Fault               Fault
+	FaultSince          time.Time
+	// UnchangedSince is when the raw values last changed
+	UnchangedSince time.Time
+
+	lastRaw []RawValue
+}
+
+func NewHealth(sensorName string, port string) *Health {
+	return &Health{
+		SensorName: sensorName,
+		Port:       port,
+	}
+}
+
+// Faulted reports whether the values of the sensor must not be used for decisions.
+func (h *Health) Faulted() bool {
+	return h.Fault != FaultNone
+}
+
+// Message describes the fault of the sensor for logs and alarms.
+func (h *Health) Message() string {
+	switch h.Fault {
+	case FaultReadErrors, FaultOutOfRange:
+		return fmt.Sprintf("%d failed reads in a row, last error: %s", h.ConsecutiveFailures, h.LastError)
+	case FaultStuck:
+		return fmt.Sprintf("raw values did not change since %s", h.UnchangedSince.Format("2006-01-02 15:04"))
+	}
+	return "healthy"
+}
+
+// Success records a successful read with the raw values the sensor delivered.
+// Raw values which stay the same for stuckAfter make the sensor stuck, 0 turns the check off.
+// It returns true if the fault of the sensor changed.
+func (h *Health) Success(now time.Time, raw []RawValue, stuckAfter time.Duration) bool {
+	h.LastSuccess = now
+	h.ConsecutiveFailures = 0
+
+	if h.UnchangedSince.IsZero() || len(raw) == 0 || !reflect.DeepEqual(raw, h.lastRaw) {
+		h.UnchangedSince = now
+		h.lastRaw = raw
+	}
+
+	if stuckAfter > 0 && now.Sub(h.UnchangedSince) >= stuckAfter {
+		return h.setFault(now, FaultStuck)
+	}
+	return h.setFault(now, FaultNone)
+}
+
+// Failure records a failed read. The sensor is faulted once maxFailures reads failed in a row.
+// It returns true if the fault of the sensor changed.
+func (h *Health) Failure(now time.Time, err error, maxFailures int) bool {
+	h.LastFailure = now
+	h.LastError = err.Error()
+	h.ConsecutiveFailures++
+
+	if h.ConsecutiveFailures < maxFailures {
+		return false
+	}
+
+	var outOfRange *OutOfRangeError
+	if errors.As(err, &outOfRange) {
+		return h.setFault(now, FaultOutOfRange)
+	}
+	return h.setFault(now, FaultReadErrors)
+}
+
+func (h *Health) setFault(now time.Time, fault Fault) bool {
+	if h.Fault == fault {
+		return false
+	}
+
+	h.Fault = fault
+	h.FaultSince = now
+	return true
+}
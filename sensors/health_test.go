@@ -0,0 +1,56 @@
+package sensors
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHealthFaultsAfterMaxFailures(t *testing.T) {
+	h := NewHealth(MoistureSensorName, "A")
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for i := 1; i < 5; i++ {
+		if h.Failure(now, &OutOfRangeError{Raw: 0, Min: 1, Max: moistureFullScale - 1}, 5) {
+			t.Fatalf("fault changed after %d failures", i)
+		}
+	}
+	if !h.Failure(now, &OutOfRangeError{Raw: 0, Min: 1, Max: moistureFullScale - 1}, 5) || h.Fault != FaultOutOfRange {
+		t.Fatalf("fault is %q after 5 out of range reads, want %q", h.Fault, FaultOutOfRange)
+	}
+
+	if !h.Success(now, moistureRawValues(1500), 0) || h.Faulted() {
+		t.Fatalf("fault is %q after a successful read, want none", h.Fault)
+	}
+
+	for i := 0; i < 5; i++ {
+		h.Failure(now, errors.New("i2c: no ack"), 5)
+	}
+	if h.Fault != FaultReadErrors {
+		t.Fatalf("fault is %q after 5 failed reads, want %q", h.Fault, FaultReadErrors)
+	}
+}
+
+func TestHealthStuck(t *testing.T) {
+	h := NewHealth(MoistureSensorName, "A")
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	h.Success(now, moistureRawValues(1500), time.Hour)
+	if h.Success(now.Add(59*time.Minute), moistureRawValues(1500), time.Hour) {
+		t.Fatal("sensor is stuck before the stuck time passed")
+	}
+	if !h.Success(now.Add(time.Hour), moistureRawValues(1500), time.Hour) || h.Fault != FaultStuck {
+		t.Fatalf("fault is %q after an hour of the same value, want %q", h.Fault, FaultStuck)
+	}
+	if !h.Success(now.Add(61*time.Minute), moistureRawValues(1501), time.Hour) || h.Faulted() {
+		t.Fatalf("fault is %q after the value changed, want none", h.Fault)
+	}
+}
+
+func TestCalibrationClampsValuesOutsideOfTheCalibratedRange(t *testing.T) {
+	for raw, want := range map[uint16]float64{500: 100, 1500: 50, 2800: 0} {
+		if got := DefaultCalibration.Percentage(raw); got != want {
+			t.Errorf("raw %d is %v%%, want %v%%", raw, got, want)
+		}
+	}
+}
@@ -1,50 +1,15 @@
 package sensors
 
 import (
-	"fmt"
-	"log"
-	"periph.io/x/conn/v3/gpio"
-	"periph.io/x/host/v3/rpi"
+	"errors"
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
+	"sync"
 	"time"
 )
 
-type StationSettings struct {
-	GroveBus string `yaml:"groveBus"`
-
-	WaterLevelHighAddress uint16 `yaml:"waterLevelHighAddress"`
-	WaterLevelLowAddress  uint16 `yaml:"waterLevelLowAddress"`
-	MoistureAddress       uint16 `yaml:"moistureAddress"`
-	PumpGPIO              int    `yaml:"pumpGPIO"`
-
-	Ports []PortSetting `yaml:"ports"`
-}
-
-type PortSetting struct {
-	Port            string `yaml:"port"`
-	MoistureChannel byte   `yaml:"moistureChannel"`
-	ValveGPIO       int    `yaml:"valveGPIO"`
-}
-
-var (
-	DefaultStationSettings = StationSettings{
-		GroveBus:              "1",
-		WaterLevelHighAddress: 0x78,
-		WaterLevelLowAddress:  0x77,
-		MoistureAddress:       0x08,
-		PumpGPIO:              23,
-		Ports: []PortSetting{
-			{
-				Port:            "A",
-				MoistureChannel: 0x0,
-				ValveGPIO:       24,
-			},
-			{
-				Port:            "B",
-				MoistureChannel: 0x02,
-				ValveGPIO:       25,
-			},
-		},
-	}
+const (
+	MoistureSensorName   = "Moisture"
+	WaterLevelSensorName = "Water Level"
 )
 
 type Sensor interface {
@@ -56,6 +21,17 @@ type PortSensor interface {
 	Port() PortSetting
 }
 
+// RawSensor is implemented by sensors which can report the raw payload their value is computed from.
+type RawSensor interface {
+	ReadRawValue() (float64, []RawValue, error)
+}
+
+type CalibratedSensor interface {
+	ReadRaw() (uint16, error)
+	Calibration() Calibration
+	SetCalibration(calibration Calibration)
+}
+
 type Worker interface {
 	Add(sensor Sensor) Worker
 	Start()
@@ -63,22 +39,35 @@ type Worker interface {
 	DataChannel() chan SensorData
 }
 
+// SensorData is a value read from a sensor. Failed reads are delivered too, with Err set and without Value,
+// so the station can tell a broken sensor from one which is just not read.
 type SensorData struct {
 	SensorName string
 	Value      float64
 	Port       PortSetting
+	Raw        []RawValue
+	Err        error
+}
+
+// RawValue is a named part of the raw payload of a sensor, like the pad bytes of a water level section.
+type RawValue struct {
+	Name   string `json:"name"`
+	Values []int  `json:"values"`
 }
 
 type sensorWorker struct {
-	running      bool
+	clock        clock.Clock
 	sensors      []Sensor
 	valueChannel chan SensorData
+	stop         chan struct{}
+	stopOnce     sync.Once
 }
 
-func NewWorker() Worker {
+func NewWorker(clock clock.Clock) Worker {
 	return &sensorWorker{
-		running:      false,
+		clock:        clock,
 		valueChannel: make(chan SensorData),
+		stop:         make(chan struct{}),
 	}
 }
 
@@ -88,95 +77,61 @@ func (sw *sensorWorker) Add(sensor Sensor) Worker {
 }
 
 func (sw *sensorWorker) Start() {
-	sw.running = true
 	go func() {
-		for sw.running {
+		for {
 			for _, sensor := range sw.sensors {
 				portSensor, ok := sensor.(PortSensor)
 
-				val, err := sensor.ReadValue()
-				if err != nil {
-					if !ok {
-						log.Println(err)
-					}
+				var val float64
+				var raw []RawValue
+				var err error
+				if rawSensor, isRaw := sensor.(RawSensor); isRaw {
+					val, raw, err = rawSensor.ReadRawValue()
+				} else {
+					val, err = sensor.ReadValue()
+				}
+				// a replay which is over is not a broken sensor, there is just nothing left to read
+				if errors.Is(err, ErrReplayFinished) {
 					continue
 				}
 
 				data := SensorData{
 					SensorName: sensor.Name(),
 					Value:      val,
+					Raw:        raw,
+					Err:        err,
 				}
 
 				if ok {
 					data.Port = portSensor.Port()
 				}
 
-				sw.valueChannel <- data
+				select {
+				case sw.valueChannel <- data:
+				case <-sw.stop:
+					return
+				}
+			}
+
+			// the timer is stopped when the worker is, so a stopped worker does not count as sleeping on the clock
+			timer := sw.clock.NewTimer(time.Second)
+			select {
+			case <-timer.C():
+			case <-sw.stop:
+				timer.Stop()
+				return
 			}
-			time.Sleep(time.Second)
 		}
 	}()
 }
 
+// Stop ends the polling, a worker can not be started again once it was stopped.
 func (sw *sensorWorker) Stop() {
-	sw.running = false
+	sw.stopOnce.Do(func() {
+		close(sw.stop)
+	})
 }
 
 func (sw *sensorWorker) DataChannel() chan SensorData {
 	return sw.valueChannel
 }
-
-func GetGPIO(gpio int) (gpio.PinIO, error) {
-	switch gpio {
-	case 2:
-		return rpi.P1_3, nil
-	case 3:
-		return rpi.P1_5, nil
-	case 4:
-		return rpi.P1_7, nil
-	case 5:
-		return rpi.P1_29, nil
-	case 6:
-		return rpi.P1_31, nil
-	case 7:
-		return rpi.P1_26, nil
-	case 8:
-		return rpi.P1_24, nil
-	case 9:
-		return rpi.P1_21, nil
-	case 10:
-		return rpi.P1_19, nil
-	case 11:
-		return rpi.P1_23, nil
-	case 12:
-		return rpi.P1_32, nil
-	case 13:
-		return rpi.P1_33, nil
-	case 16:
-		return rpi.P1_36, nil
-	case 17:
-		return rpi.P1_11, nil
-	case 18:
-		return rpi.P1_12, nil
-	case 19:
-		return rpi.P1_35, nil
-	case 20:
-		return rpi.P1_38, nil
-	case 21:
-		return rpi.P1_40, nil
-	case 22:
-		return rpi.P1_15, nil
-	case 23:
-		return rpi.P1_16, nil
-	case 24:
-		return rpi.P1_18, nil
-	case 25:
-		return rpi.P1_22, nil
-	case 26:
-		return rpi.P1_37, nil
-	case 27:
-		return rpi.P1_13, nil
-	default:
-		return nil, fmt.Errorf("gpio %d cant found", gpio)
-	}
-}
@@ -0,0 +1,117 @@
+package sensors
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Record is a single reading of a recording, it is stored as one line of JSON.
+// Failed reads are recorded too, with the error and for implausible raw values the range they were out of,
+// so a replay runs into the same sensor faults as the station which was recorded.
+type Record struct {
+	Time       time.Time        `json:"time"`
+	SensorName string           `json:"sensorName"`
+	Port       string           `json:"port,omitempty"`
+	Value      float64          `json:"value"`
+	Raw        []RawValue       `json:"raw,omitempty"`
+	Error      string           `json:"error,omitempty"`
+	OutOfRange *OutOfRangeError `json:"outOfRange,omitempty"`
+}
+
+// Err returns the error of a failed read, or nil for a successful one.
+func (r *Record) Err() error {
+	if r.OutOfRange != nil {
+		return r.OutOfRange
+	}
+	if r.Error != "" {
+		return errors.New(r.Error)
+	}
+	return nil
+}
+
+// Recorder appends the sensor data of a station to a file, so it can be replayed later.
+type Recorder struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func NewRecorder(path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		file: file,
+	}, nil
+}
+
+func (r *Recorder) Record(t time.Time, data SensorData) error {
+	record := Record{
+		Time:       t.UTC(),
+		SensorName: data.SensorName,
+		Port:       data.Port.Port,
+		Value:      data.Value,
+		Raw:        data.Raw,
+	}
+	if data.Err != nil {
+		record.Error = data.Err.Error()
+		errors.As(data.Err, &record.OutOfRange)
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, err = r.file.Write(append(line, '\n'))
+	return err
+}
+
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.file.Close()
+}
+
+// LoadRecording reads all records of a recording file in the order they were recorded.
+func LoadRecording(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	records := make([]Record, 0)
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record Record
+		if err = json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", path, line, err)
+		}
+		records = append(records, record)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
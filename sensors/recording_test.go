@@ -0,0 +1,54 @@
+package sensors
+
+import (
+	"errors"
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestReplayReturnsTheRecordedErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	recorder, err := NewRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	port := PortSetting{Port: "A"}
+	start := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	for i, data := range []SensorData{
+		{SensorName: MoistureSensorName, Port: port, Value: 45, Raw: moistureRawValues(1500)},
+		{SensorName: MoistureSensorName, Port: port, Err: errors.New("i2c: no ack")},
+		{SensorName: MoistureSensorName, Port: port, Raw: moistureRawValues(0), Err: &OutOfRangeError{Raw: 0, Min: 1, Max: moistureFullScale - 1}},
+	} {
+		if err = recorder.Record(start.Add(time.Duration(i)*time.Second), data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := LoadRecording(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manual := clock.NewManual(start)
+	replay := NewReplay(records, RecordingStart(records), MoistureSensorName, port, manual).(*Replay)
+
+	if value, _, err := replay.ReadRawValue(); err != nil || value != 45 {
+		t.Errorf("first read returned %v, %v, want 45", value, err)
+	}
+
+	manual.Advance(time.Second)
+	if _, _, err := replay.ReadRawValue(); err == nil || err.Error() != "i2c: no ack" {
+		t.Errorf("second read returned %v, want the recorded read error", err)
+	}
+
+	manual.Advance(time.Second)
+	var outOfRange *OutOfRangeError
+	if _, _, err := replay.ReadRawValue(); !errors.As(err, &outOfRange) || outOfRange.Max != moistureFullScale-1 {
+		t.Errorf("third read returned %v, want the recorded out of range error", err)
+	}
+}
@@ -3,12 +3,20 @@ package sensors
 import (
 	"encoding/binary"
 	"periph.io/x/conn/v3/i2c"
+	"sync"
 )
 
+// moistureFullScale is the raw value of the reference voltage, the ADC of the Grove Base Hat reports millivolts of 3.3V.
+// A probe only reads 0 or full scale when it is disconnected or shorted. Wetter or drier soil than calibrated
+// reads values in between, which the calibration clamps to 100 or 0 percent.
+const moistureFullScale = 3300
+
 type moisture struct {
-	bus     i2c.BusCloser
-	dev     i2c.Dev
-	setting PortSetting
+	bus         i2c.BusCloser
+	dev         i2c.Dev
+	setting     PortSetting
+	mutex       sync.RWMutex
+	calibration Calibration
 }
 
 func NewMoisture(bus i2c.BusCloser, address uint16, setting PortSetting) Sensor {
@@ -18,19 +26,34 @@ func NewMoisture(bus i2c.BusCloser, address uint16, setting PortSetting) Sensor
 			Bus:  bus,
 			Addr: address,
 		},
-		setting: setting,
+		setting:     setting,
+		calibration: setting.Calibration,
 	}
 }
 
 func (s *moisture) Name() string {
-	return "Moisture"
+	return MoistureSensorName
 }
 
 func (s *moisture) Port() PortSetting {
 	return s.setting
 }
 
-func (s *moisture) ReadValue() (float64, error) {
+func (s *moisture) Calibration() Calibration {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.calibration
+}
+
+func (s *moisture) SetCalibration(calibration Calibration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.calibration = calibration
+}
+
+func (s *moisture) ReadRaw() (uint16, error) {
 
 	write := []byte{0x20 + s.setting.MoistureChannel}
 	read := make([]byte, 2)
@@ -38,18 +61,32 @@ func (s *moisture) ReadValue() (float64, error) {
 		return 0, err
 	}
 
-	val := float64(binary.LittleEndian.Uint16(read))
+	return binary.LittleEndian.Uint16(read), nil
+}
+
+func (s *moisture) ReadValue() (float64, error) {
+	val, _, err := s.ReadRawValue()
+	return val, err
+}
 
-	if val <= 1000 {
-		return 100, nil
+func (s *moisture) ReadRawValue() (float64, []RawValue, error) {
+	raw, err := s.ReadRaw()
+	if err != nil {
+		return 0, nil, err
 	}
 
-	if val >= 2000 {
-		return 0, nil
+	if raw == 0 || raw >= moistureFullScale {
+		return 0, moistureRawValues(raw), &OutOfRangeError{Raw: int(raw), Min: 1, Max: moistureFullScale - 1}
 	}
 
-	diff := -(val - 2000)
-	percentage := diff / 10
+	return s.Calibration().Percentage(raw), moistureRawValues(raw), nil
+}
 
-	return percentage, nil
+func moistureRawValues(raw uint16) []RawValue {
+	return []RawValue{
+		{
+			Name:   "adc",
+			Values: []int{int(raw)},
+		},
+	}
 }
@@ -1,19 +1,28 @@
 package sensors
 
+import (
+	"sync"
+)
+
+// MoistureFake behaves like a moisture sensor with the default calibration,
+// so calibrating it changes the reported percentages like it would with real hardware.
 type MoistureFake struct {
-	setting PortSetting
-	value   float64
+	setting     PortSetting
+	mutex       sync.RWMutex
+	value       float64
+	calibration Calibration
 }
 
 func NewMoistureFake(setting PortSetting) Sensor {
 	return &MoistureFake{
-		setting: setting,
-		value:   100,
+		setting:     setting,
+		value:       100,
+		calibration: setting.Calibration,
 	}
 }
 
 func (s *MoistureFake) Name() string {
-	return "Moisture"
+	return MoistureSensorName
 }
 
 func (s *MoistureFake) Port() PortSetting {
@@ -21,9 +30,51 @@ func (s *MoistureFake) Port() PortSetting {
 }
 
 func (s *MoistureFake) SetValue(val float64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	s.value = val
 }
 
+// Value returns the moisture the fake was set to, before any calibration is applied.
+func (s *MoistureFake) Value() float64 {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.value
+}
+
+func (s *MoistureFake) Calibration() Calibration {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.calibration
+}
+
+func (s *MoistureFake) SetCalibration(calibration Calibration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.calibration = calibration
+}
+
+func (s *MoistureFake) ReadRaw() (uint16, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return DefaultCalibration.Raw(s.value), nil
+}
+
 func (s *MoistureFake) ReadValue() (float64, error) {
-	return s.value, nil
+	val, _, err := s.ReadRawValue()
+	return val, err
+}
+
+func (s *MoistureFake) ReadRawValue() (float64, []RawValue, error) {
+	raw, err := s.ReadRaw()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return s.Calibration().Percentage(raw), moistureRawValues(raw), nil
 }
@@ -0,0 +1,103 @@
+package sensors
+
+import (
+	"errors"
+	"github.com/ZamarianPatrick/lazypig-backend/clock"
+	"sort"
+	"time"
+)
+
+var ErrReplayFinished = errors.New("replay finished")
+
+// Replay plays back the recorded readings of one sensor. The recording starts when the replay is created
+// and runs along the clock, so with a manual or accelerated clock hours of readings replay in seconds.
+// Every read returns the latest record due, a recorded failed read fails again with the recorded error.
+// Once the recording is over reads fail with ErrReplayFinished.
+type Replay struct {
+	name    string
+	setting PortSetting
+	clock   clock.Clock
+	start   time.Time
+	from    time.Time
+	records []Record
+}
+
+// NewReplay replays the records of the sensor with the given name on the port of setting, records of other sensors are skipped.
+// All replays of a station should share the same start, which is the time of the first record of the whole recording.
+func NewReplay(records []Record, start time.Time, name string, setting PortSetting, clock clock.Clock) Sensor {
+	own := make([]Record, 0)
+	for _, r := range records {
+		if r.SensorName == name && r.Port == setting.Port {
+			own = append(own, r)
+		}
+	}
+
+	sort.SliceStable(own, func(i, j int) bool {
+		return own[i].Time.Before(own[j].Time)
+	})
+
+	return &Replay{
+		name:    name,
+		setting: setting,
+		clock:   clock,
+		start:   clock.Now(),
+		from:    start,
+		records: own,
+	}
+}
+
+// RecordingStart returns the time of the first record, which is where replays of the recording start.
+func RecordingStart(records []Record) time.Time {
+	var start time.Time
+	for _, r := range records {
+		if start.IsZero() || r.Time.Before(start) {
+			start = r.Time
+		}
+	}
+	return start
+}
+
+func (s *Replay) Name() string {
+	return s.name
+}
+
+func (s *Replay) Port() PortSetting {
+	return s.setting
+}
+
+func (s *Replay) ReadValue() (float64, error) {
+	val, _, err := s.ReadRawValue()
+	return val, err
+}
+
+func (s *Replay) ReadRawValue() (float64, []RawValue, error) {
+	record, err := s.current()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return record.Value, record.Raw, record.Err()
+}
+
+// current returns the latest record which is due at the current time of the replay.
+func (s *Replay) current() (*Record, error) {
+	if len(s.records) == 0 {
+		return nil, ErrReplayFinished
+	}
+
+	at := s.from.Add(s.clock.Since(s.start))
+
+	i := sort.Search(len(s.records), func(i int) bool {
+		return s.records[i].Time.After(at)
+	})
+
+	// the last record stays for one poll interval, so it is read once more like all the others
+	if i == len(s.records) && at.After(s.records[i-1].Time.Add(time.Second)) {
+		return nil, ErrReplayFinished
+	}
+	if i == 0 {
+		return &s.records[0], nil
+	}
+
+	return &s.records[i-1], nil
+}
@@ -1,6 +1,11 @@
 package sensors
 
+import (
+	"sync"
+)
+
 type WaterFake struct {
+	mutex sync.RWMutex
 	value float64
 }
 
@@ -11,13 +16,25 @@ func NewWaterFake(value float64) Sensor {
 }
 
 func (s *WaterFake) Name() string {
-	return "Water Level"
+	return WaterLevelSensorName
 }
 
 func (s *WaterFake) SetValue(val float64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	s.value = val
 }
 
 func (s *WaterFake) ReadValue() (float64, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	return s.value, nil
 }
+
+func (s *WaterFake) ReadRawValue() (float64, []RawValue, error) {
+	value, _ := s.ReadValue()
+	readLow, readHigh := waterLevelPads(value)
+	return value, waterLevelRawValues(readLow, readHigh), nil
+}
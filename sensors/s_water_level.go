@@ -25,22 +25,32 @@ func NewWaterLevel(bus i2c.BusCloser, highAddress uint16, lowAddress uint16) Sen
 }
 
 func (s *waterLevel) Name() string {
-	return "Water Level"
+	return WaterLevelSensorName
 }
 
 func (s *waterLevel) ReadValue() (float64, error) {
+	val, _, err := s.ReadRawValue()
+	return val, err
+}
+
+func (s *waterLevel) ReadRawValue() (float64, []RawValue, error) {
 
 	readHigh := make([]byte, 12)
 	readLow := make([]byte, 8)
 
 	if err := s.high.Tx(nil, readHigh); err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	if err := s.low.Tx(nil, readLow); err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
+	return waterLevelFromPads(readLow, readHigh), waterLevelRawValues(readLow, readHigh), nil
+}
+
+// waterLevelFromPads computes the water level in percent from the capacitive pads of the low (8 pads) and high (12 pads) section.
+func waterLevelFromPads(readLow []byte, readHigh []byte) float64 {
 	threshold := byte(100)
 	sensorValueMin := byte(250)
 	sensorValueMax := byte(255)
@@ -79,5 +89,45 @@ func (s *waterLevel) ReadValue() (float64, error) {
 		touchValue >>= 1
 	}
 
-	return float64(trigSection * 5), nil
+	return float64(trigSection * 5)
+}
+
+// waterLevelPads returns pad bytes as the sensor would report them for the given water level.
+func waterLevelPads(level float64) ([]byte, []byte) {
+	readLow := make([]byte, 8)
+	readHigh := make([]byte, 12)
+
+	touched := int(level / 5)
+	for i := 0; i < touched && i < 20; i++ {
+		if i < 8 {
+			readLow[i] = 255
+		} else {
+			readHigh[i-8] = 255
+		}
+	}
+
+	return readLow, readHigh
+}
+
+func waterLevelRawValues(readLow []byte, readHigh []byte) []RawValue {
+	low := make([]int, len(readLow))
+	for i, b := range readLow {
+		low[i] = int(b)
+	}
+
+	high := make([]int, len(readHigh))
+	for i, b := range readHigh {
+		high[i] = int(b)
+	}
+
+	return []RawValue{
+		{
+			Name:   "low",
+			Values: low,
+		},
+		{
+			Name:   "high",
+			Values: high,
+		},
+	}
 }
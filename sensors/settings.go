@@ -0,0 +1,294 @@
+package sensors
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"os"
+)
+
+type Settings struct {
+	Stations []StationSettings `yaml:"stations"`
+}
+
+type StationSettings struct {
+	StationID uint64 `yaml:"stationID"`
+	Name      string `yaml:"name"`
+	GroveBus  string `yaml:"groveBus"`
+
+	WaterLevelHighAddress uint16 `yaml:"waterLevelHighAddress"`
+	WaterLevelLowAddress  uint16 `yaml:"waterLevelLowAddress"`
+	MoistureAddress       uint16 `yaml:"moistureAddress"`
+	PumpGPIO              int    `yaml:"pumpGPIO"`
+
+	MaxDailyPumpSeconds int     `yaml:"maxDailyPumpSeconds"`
+	MinWaterLevel       float64 `yaml:"minWaterLevel"`
+	// ReservoirAlertLevel is the water level in percent below which an alert asks to refill the reservoir
+	ReservoirAlertLevel float64 `yaml:"reservoirAlertLevel"`
+
+	// MaxSensorFailures is the number of failed reads in a row after which a sensor is faulted
+	MaxSensorFailures int `yaml:"maxSensorFailures"`
+	// StuckSensorMinutes is how long the raw values of a moisture sensor may stay the same before it is faulted
+	StuckSensorMinutes int `yaml:"stuckSensorMinutes"`
+
+	Ports []PortSetting `yaml:"ports"`
+
+	Simulation SimulationSettings `yaml:"simulation"`
+
+	// RecordFile is a file all sensor data of the station is appended to as JSON lines
+	RecordFile string `yaml:"recordFile,omitempty"`
+	// ReplayFile is a recording the station replays instead of reading its sensors, its pump and valves are faked then
+	ReplayFile string `yaml:"replayFile,omitempty"`
+}
+
+// SimulationSettings describe how the fake sensors of a station follow the fake pump and valves.
+// They are only used when the station runs with fake values.
+type SimulationSettings struct {
+	Enabled bool `yaml:"enabled"`
+	// EvaporationRate is the moisture in percent a plant loses per hour, unless the plant has its own rate
+	EvaporationRate float64 `yaml:"evaporationRate"`
+	// ValveFlowRate is the moisture in percent a plant gains per second while its valve is open and the pump runs
+	ValveFlowRate float64 `yaml:"valveFlowRate"`
+	// PumpDrainRate is the reservoir level in percent lost per second while the pump runs
+	PumpDrainRate float64 `yaml:"pumpDrainRate"`
+}
+
+type PortSetting struct {
+	Port            string `yaml:"port"`
+	MoistureChannel byte   `yaml:"moistureChannel"`
+	ValveGPIO       int    `yaml:"valveGPIO"`
+	MaxOpenSeconds  int    `yaml:"maxOpenSeconds"`
+	CooldownSeconds int    `yaml:"cooldownSeconds"`
+
+	Calibration Calibration `yaml:"calibration"`
+}
+
+const (
+	DefaultMaxOpenSeconds      = 120
+	DefaultCooldownSeconds     = 600
+	DefaultMaxDailyPumpSeconds = 1800
+	DefaultMinWaterLevel       = 5
+	DefaultReservoirAlertLevel = 20
+	DefaultMaxSensorFailures   = 5
+	DefaultStuckSensorMinutes  = 360
+
+	DefaultEvaporationRate = 2
+	DefaultValveFlowRate   = 1
+	DefaultPumpDrainRate   = 0.2
+)
+
+var (
+	DefaultStationSettings = StationSettings{
+		StationID:             1,
+		Name:                  "Station 1",
+		GroveBus:              "1",
+		WaterLevelHighAddress: 0x78,
+		WaterLevelLowAddress:  0x77,
+		MoistureAddress:       0x08,
+		PumpGPIO:              23,
+		MaxDailyPumpSeconds:   DefaultMaxDailyPumpSeconds,
+		MinWaterLevel:         DefaultMinWaterLevel,
+		ReservoirAlertLevel:   DefaultReservoirAlertLevel,
+		MaxSensorFailures:     DefaultMaxSensorFailures,
+		StuckSensorMinutes:    DefaultStuckSensorMinutes,
+		Ports: []PortSetting{
+			{
+				Port:            "A",
+				MoistureChannel: 0x0,
+				ValveGPIO:       24,
+				MaxOpenSeconds:  DefaultMaxOpenSeconds,
+				CooldownSeconds: DefaultCooldownSeconds,
+				Calibration:     DefaultCalibration,
+			},
+			{
+				Port:            "B",
+				MoistureChannel: 0x02,
+				ValveGPIO:       25,
+				MaxOpenSeconds:  DefaultMaxOpenSeconds,
+				CooldownSeconds: DefaultCooldownSeconds,
+				Calibration:     DefaultCalibration,
+			},
+		},
+		Simulation: SimulationSettings{
+			Enabled:         true,
+			EvaporationRate: DefaultEvaporationRate,
+			ValveFlowRate:   DefaultValveFlowRate,
+			PumpDrainRate:   DefaultPumpDrainRate,
+		},
+	}
+
+	DefaultSettings = Settings{
+		Stations: []StationSettings{
+			DefaultStationSettings,
+		},
+	}
+)
+
+// LoadSettings reads the settings file at path and writes the default settings to it if it does not exist yet.
+// Files written before multiple stations were supported only describe a single station, they are loaded as station 1.
+func LoadSettings(path string) (*Settings, error) {
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		settings := DefaultSettings
+		if err := SaveSettings(path, &settings); err != nil {
+			return nil, err
+		}
+		return &settings, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings Settings
+	if err = yaml.Unmarshal(data, &settings); err != nil {
+		return nil, err
+	}
+
+	if len(settings.Stations) == 0 {
+		var station StationSettings
+		if err = yaml.Unmarshal(data, &station); err != nil {
+			return nil, err
+		}
+
+		if station.StationID == 0 {
+			station.StationID = 1
+		}
+		settings.Stations = []StationSettings{station}
+	}
+
+	settings.ApplyDefaults()
+	if err = settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// ApplyDefaults gives the ports without calibration the default calibration.
+// Limits and rates get their defaults when the settings are read or converted from an input.
+func (s *Settings) ApplyDefaults() {
+	for i := range s.Stations {
+		for j := range s.Stations[i].Ports {
+			port := &s.Stations[i].Ports[j]
+			if port.Calibration.Dry == 0 && port.Calibration.Wet == 0 && len(port.Calibration.Points) == 0 {
+				port.Calibration = DefaultCalibration
+			}
+		}
+	}
+}
+
+// Validate checks that stations and their ports are unique and the calibrations are usable.
+// Whether the GPIOs exist and are used only once depends on the board, so they are checked by its users.
+func (s *Settings) Validate() error {
+	stationIDs := make(map[uint64]bool)
+	for _, station := range s.Stations {
+		if station.StationID == 0 {
+			return errors.New("every station in the settings needs a stationID")
+		}
+		if stationIDs[station.StationID] {
+			return fmt.Errorf("station %d is configured more than once", station.StationID)
+		}
+		stationIDs[station.StationID] = true
+
+		ports := make(map[string]bool)
+		channels := make(map[byte]string)
+		for _, port := range station.Ports {
+			if port.Port == "" {
+				return fmt.Errorf("station %d has a port without name", station.StationID)
+			}
+			if ports[port.Port] {
+				return fmt.Errorf("station %d has port %s more than once", station.StationID, port.Port)
+			}
+			ports[port.Port] = true
+
+			if other, ok := channels[port.MoistureChannel]; ok {
+				return fmt.Errorf("station %d ports %s and %s use the same moisture channel %d", station.StationID, other, port.Port, port.MoistureChannel)
+			}
+			channels[port.MoistureChannel] = port.Port
+
+			if port.MaxOpenSeconds <= 0 || port.CooldownSeconds < 0 {
+				return fmt.Errorf("station %d port %s needs a positive max open time and a cooldown not below 0", station.StationID, port.Port)
+			}
+
+			if err := port.Calibration.Validate(); err != nil {
+				return fmt.Errorf("station %d port %s: %w", station.StationID, port.Port, err)
+			}
+		}
+
+		if station.MaxDailyPumpSeconds <= 0 {
+			return fmt.Errorf("station %d needs a positive daily pump limit", station.StationID)
+		}
+		if station.MinWaterLevel < 0 || station.MinWaterLevel > 100 {
+			return fmt.Errorf("station %d min water level must be between 0 and 100", station.StationID)
+		}
+		if station.ReservoirAlertLevel < 0 || station.ReservoirAlertLevel > 100 {
+			return fmt.Errorf("station %d reservoir alert level must be between 0 and 100", station.StationID)
+		}
+		if station.MaxSensorFailures <= 0 || station.StuckSensorMinutes <= 0 {
+			return fmt.Errorf("station %d needs a positive number of sensor failures and stuck sensor minutes", station.StationID)
+		}
+	}
+
+	return nil
+}
+
+// NewStationSettings returns station settings with the default limits and simulation rates, the station itself,
+// its sensors and its ports still have to be set.
+func NewStationSettings() StationSettings {
+	return StationSettings{
+		MaxDailyPumpSeconds: DefaultMaxDailyPumpSeconds,
+		MinWaterLevel:       DefaultMinWaterLevel,
+		ReservoirAlertLevel: DefaultReservoirAlertLevel,
+		MaxSensorFailures:   DefaultMaxSensorFailures,
+		StuckSensorMinutes:  DefaultStuckSensorMinutes,
+		Simulation: SimulationSettings{
+			EvaporationRate: DefaultEvaporationRate,
+			ValveFlowRate:   DefaultValveFlowRate,
+			PumpDrainRate:   DefaultPumpDrainRate,
+		},
+	}
+}
+
+// NewPortSetting returns a port setting with the default safety limits.
+func NewPortSetting() PortSetting {
+	return PortSetting{
+		MaxOpenSeconds:  DefaultMaxOpenSeconds,
+		CooldownSeconds: DefaultCooldownSeconds,
+	}
+}
+
+// UnmarshalYAML starts from the defaults, so only the limits and rates missing in settings files written before
+// they existed get their defaults. A value set to 0 stays 0: where 0 is allowed, like a cooldown or a minimum water level,
+// it is used as it is, the max open time and the daily pump limit can not be turned off and Validate rejects them.
+func (s *StationSettings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain StationSettings
+	settings := plain(NewStationSettings())
+	if err := unmarshal(&settings); err != nil {
+		return err
+	}
+
+	*s = StationSettings(settings)
+	return nil
+}
+
+func (p *PortSetting) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain PortSetting
+	setting := plain(NewPortSetting())
+	if err := unmarshal(&setting); err != nil {
+		return err
+	}
+
+	*p = PortSetting(setting)
+	return nil
+}
+
+func SaveSettings(path string, settings *Settings) error {
+	data, err := yaml.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
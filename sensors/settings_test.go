@@ -0,0 +1,121 @@
+package sensors
+
+import (
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func loadSettingsFile(t *testing.T, content string) *Settings {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "stationSettings.yml")
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := LoadSettings(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return settings
+}
+
+func TestLoadSettingsKeepsZeroLimits(t *testing.T) {
+	settings := loadSettingsFile(t, `
+stations:
+- stationID: 1
+  minWaterLevel: 0
+  reservoirAlertLevel: 0
+  ports:
+  - port: A
+    cooldownSeconds: 0
+`)
+
+	station := settings.Stations[0]
+	if station.MinWaterLevel != 0 {
+		t.Errorf("min water level is %v, want 0", station.MinWaterLevel)
+	}
+	if station.ReservoirAlertLevel != 0 {
+		t.Errorf("reservoir alert level is %v, want 0", station.ReservoirAlertLevel)
+	}
+	if station.Ports[0].CooldownSeconds != 0 {
+		t.Errorf("cooldown is %d, want 0", station.Ports[0].CooldownSeconds)
+	}
+}
+
+func TestSafetyLimitsSetTo0AreRejected(t *testing.T) {
+	for _, content := range []string{`
+stations:
+- stationID: 1
+  maxDailyPumpSeconds: 0
+  ports:
+  - port: A
+`, `
+stations:
+- stationID: 1
+  ports:
+  - port: A
+    maxOpenSeconds: 0
+`} {
+		var settings Settings
+		if err := yaml.Unmarshal([]byte(content), &settings); err != nil {
+			t.Fatal(err)
+		}
+		if err := settings.Validate(); err == nil {
+			t.Errorf("settings with a safety limit of 0 are valid:%s", content)
+		}
+	}
+}
+
+func TestLoadSettingsDefaultsMissingLimits(t *testing.T) {
+	settings := loadSettingsFile(t, `
+stations:
+- stationID: 1
+  simulation:
+    enabled: true
+  ports:
+  - port: A
+`)
+
+	station := settings.Stations[0]
+	if station.MinWaterLevel != DefaultMinWaterLevel {
+		t.Errorf("min water level is %v, want %v", station.MinWaterLevel, DefaultMinWaterLevel)
+	}
+	if station.MaxDailyPumpSeconds != DefaultMaxDailyPumpSeconds {
+		t.Errorf("daily pump limit is %d, want %d", station.MaxDailyPumpSeconds, DefaultMaxDailyPumpSeconds)
+	}
+	if station.Simulation.EvaporationRate != DefaultEvaporationRate {
+		t.Errorf("evaporation rate is %v, want %v", station.Simulation.EvaporationRate, DefaultEvaporationRate)
+	}
+
+	port := station.Ports[0]
+	if port.MaxOpenSeconds != DefaultMaxOpenSeconds || port.CooldownSeconds != DefaultCooldownSeconds {
+		t.Errorf("port limits are %d and %d, want %d and %d", port.MaxOpenSeconds, port.CooldownSeconds, DefaultMaxOpenSeconds, DefaultCooldownSeconds)
+	}
+	if !reflect.DeepEqual(port.Calibration, DefaultCalibration) {
+		t.Errorf("calibration is %+v, want the default", port.Calibration)
+	}
+}
+
+func TestLoadSettingsOfSingleStationFile(t *testing.T) {
+	settings := loadSettingsFile(t, `
+name: Balcony
+ports:
+- port: A
+  maxOpenSeconds: 30
+`)
+
+	if len(settings.Stations) != 1 || settings.Stations[0].StationID != 1 {
+		t.Fatalf("got stations %+v, want station 1", settings.Stations)
+	}
+	station := settings.Stations[0]
+	if station.Name != "Balcony" || station.MinWaterLevel != DefaultMinWaterLevel {
+		t.Errorf("got %+v", station)
+	}
+	if station.Ports[0].MaxOpenSeconds != 30 || station.Ports[0].CooldownSeconds != DefaultCooldownSeconds {
+		t.Errorf("got port %+v", station.Ports[0])
+	}
+}
@@ -0,0 +1,112 @@
+package simulation
+
+import (
+	"fmt"
+	"github.com/ZamarianPatrick/lazypig-backend/actuators"
+	"github.com/ZamarianPatrick/lazypig-backend/sensors"
+	"math"
+	"sync"
+	"time"
+)
+
+// Simulation connects the fake sensors of a station to its fake relays, so the closed loop behaves like a real station:
+// plants dry out over time, get wetter while their valve is open and the pump runs, and the reservoir empties while the pump runs.
+type Simulation struct {
+	settings sensors.SimulationSettings
+	pump     *actuators.Relay
+	water    *sensors.WaterFake
+
+	mutex sync.Mutex
+	ports map[string]*port
+	last  time.Time
+}
+
+type port struct {
+	valve           *actuators.Relay
+	moisture        *sensors.MoistureFake
+	evaporationRate float64
+}
+
+func New(settings sensors.SimulationSettings, board actuators.Board, pumpGPIO int, water *sensors.WaterFake) (*Simulation, error) {
+	pump, err := actuators.NewRelay(board, pumpGPIO)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Simulation{
+		settings: settings,
+		pump:     pump,
+		water:    water,
+		ports:    make(map[string]*port),
+	}, nil
+}
+
+// AddPort lets the moisture of the port follow its valve.
+func (s *Simulation) AddPort(setting sensors.PortSetting, board actuators.Board, moisture *sensors.MoistureFake) error {
+	valve, err := actuators.NewRelay(board, setting.ValveGPIO)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.ports[setting.Port] = &port{
+		valve:    valve,
+		moisture: moisture,
+	}
+	return nil
+}
+
+// SetEvaporationRate sets the moisture in percent the plant on the port loses per hour, 0 uses the rate of the settings.
+func (s *Simulation) SetEvaporationRate(portName string, rate float64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	p, ok := s.ports[portName]
+	if !ok {
+		return fmt.Errorf("port %s is not simulated", portName)
+	}
+
+	p.evaporationRate = rate
+	return nil
+}
+
+// Step advances the simulation to now, the first step only remembers the time.
+func (s *Simulation) Step(now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.last.IsZero() || now.Before(s.last) {
+		s.last = now
+		return
+	}
+
+	elapsed := now.Sub(s.last).Seconds()
+	s.last = now
+
+	level, _ := s.water.ReadValue()
+	flowing := s.pump.IsOn() && level > 0
+
+	if flowing {
+		s.water.SetValue(clamp(level - s.settings.PumpDrainRate*elapsed))
+	}
+
+	for _, p := range s.ports {
+		rate := p.evaporationRate
+		if rate == 0 {
+			rate = s.settings.EvaporationRate
+		}
+
+		moisture := p.moisture.Value() - rate*elapsed/3600
+		if flowing && p.valve.IsOn() {
+			moisture += s.settings.ValveFlowRate * elapsed
+		}
+
+		p.moisture.SetValue(clamp(moisture))
+	}
+}
+
+func clamp(value float64) float64 {
+	return math.Max(0, math.Min(100, value))
+}